@@ -9,90 +9,144 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var ackSeverityFlag string
-var ackDashboardName string
+var (
+	ackFilters          problemFilters
+	ackMessage          string
+	ackClose            bool
+	ackDryRun           bool
+	ackYes              bool
+	ackConfirmThreshold int
+	ackInteractive      bool
+)
 
 var ackCmd = &cobra.Command{
-	Use:   "ack",
+	Use:   "ack [eventid...]",
 	Short: "acknowledge events",
-	Long:  `acknowledge events`,
-	Run: func(_ *cobra.Command, _ []string) {
-		var err error
+	Long: `Acknowledge problems, selected by event IDs and/or the same filters as 'problem get'.
+
+Problems are only acknowledged: use --message to add a message and --close to also close them.
+Use --dry-run to list the problems that would be acknowledged without changing anything.
+When more problems than --confirm-threshold are selected, a confirmation is asked unless --yes is given.
+
+With --interactive, the matching problems are listed in an interactive picker (type to fuzzy search
+on host and problem name) and the actions to apply are chosen in a menu, like 'problem triage'.
+--message and --close preselect their actions in the menu, and --dry-run, --yes and
+--confirm-threshold apply to the selected problems.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := context.Background()
 
-		err = initConfig()
+		z, logout, err := newClient(ctx)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v\n", err.Error())
-			os.Exit(1)
+			return err
+		}
+		defer logout()
+
+		if ackInteractive {
+			return runProblemTriage(ctx, cmd, z, &ackFilters, args, triageOptions{
+				defaultActions:   ackTriageDefaults(ackMessage, ackClose),
+				message:          ackMessage,
+				dryRun:           ackDryRun,
+				yes:              ackYes,
+				confirmThreshold: ackConfirmThreshold,
+			})
 		}
 
-		z := zabbix.New(conf.ZabbixUser, conf.ZabbixPassword, conf.ZabbixEndpoint)
-		err = z.Login(ctx)
+		problemOptions, err := ackFilters.options(ctx, z)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v\n", err.Error())
-			os.Exit(1)
+			return err
 		}
-		defer z.Logout(ctx) //nolint: errcheck
-
-		var problemOptions []zabbix.GetProblemOption
+		if len(args) > 0 {
+			problemOptions = append(problemOptions, zabbix.GetProblemOptionEventIDs(args))
+		}
+		problemOptions = append(problemOptions, zabbix.GetProblemOptionSelectHosts("extend"))
 
-		// Apply dashboard filters if specified
-		if ackDashboardName != "" {
-			filter := map[string]interface{}{
-				"name": ackDashboardName,
-			}
-			dashboardReq := zabbix.NewDashboardGetRequest(
-				zabbix.WithDashboardGetAuth(z.Auth()),
-				zabbix.WithDashboardGetID(1),
-				zabbix.WithDashboardGetOutput("extend"),
-				zabbix.WithDashboardGetSelectPages("extend"),
-				zabbix.WithDashboardGetFilter(filter),
-			)
-
-			dashboardResp, err := z.DashboardGet(ctx, dashboardReq)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Failed to get dashboard: %v\n", err.Error())
-				os.Exit(1)
-			}
+		problems, err := z.GetProblems(ctx, problemOptions...)
+		if err != nil {
+			return fmt.Errorf("failed to get problems: %w", err)
+		}
+		warnMissingEvents(args, problems)
 
-			if len(dashboardResp.Result) == 0 {
-				fmt.Fprintf(os.Stderr, "Dashboard '%s' not found\n", ackDashboardName)
-				os.Exit(1)
-			}
+		if len(problems) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No problem to acknowledge")
+			return nil
+		}
 
-			dashboardFilters, err := zabbix.ParseProblemsWidgetFilters(&dashboardResp.Result[0])
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Failed to parse dashboard filters: %v\n", err.Error())
-				os.Exit(1)
+		if ackDryRun || len(problems) > ackConfirmThreshold {
+			if err := PrettyPrintProblems(problems); err != nil {
+				return err
 			}
-
-			if len(dashboardFilters) == 0 {
-				fmt.Fprintf(os.Stderr, "Warning: Dashboard '%s' has no 'problems' widget or no filters\n", ackDashboardName)
+		}
+		if ackDryRun {
+			fmt.Fprintf(cmd.OutOrStdout(), "Dry run: %d problem(s) would be acknowledged\n", len(problems))
+			return nil
+		}
+		if needsConfirmation(len(problems), ackConfirmThreshold, ackYes) {
+			if !confirm(cmd.InOrStdin(), cmd.OutOrStdout(), fmt.Sprintf("Acknowledge %d problems?", len(problems))) {
+				return fmt.Errorf("aborted by user")
 			}
-
-			problemOptions = append(problemOptions, dashboardFilters...)
 		}
 
-		// Apply CLI severity flag (overrides dashboard severity if both are set)
-		if ackSeverityFlag != "" {
-			severityInt := zabbix.GetSeverityString(ackSeverityFlag)
-			// ProblemParams.Severities expects []string of integer severities
-			problemOptions = append(problemOptions, zabbix.GetProblemOptionSeverities([]string{fmt.Sprintf("%d", severityInt)}))
+		actions := []zabbix.EventAction{zabbix.Acknowledge}
+		ackOptions := []zabbix.EventAcknowledgeRequestOption{}
+		if ackMessage != "" {
+			actions = append(actions, zabbix.AddMessage)
+			ackOptions = append(ackOptions, zabbix.WithMessage(ackMessage))
 		}
-
-		problems, err := z.GetProblems(ctx, problemOptions...)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v\n", err.Error())
-			os.Exit(1)
+		if ackClose {
+			actions = append(actions, zabbix.CloseProblem)
 		}
+		ackOptions = append(ackOptions, zabbix.WithActions(actions...))
 
+		var failed int
 		for _, pb := range problems {
-			fmt.Printf("Acknowledge problem %s\n", pb.Name)
-			_, err = z.AcknowledgeEvents(ctx, []string{pb.EventID}, zabbix.WithActions(zabbix.AddMessage, zabbix.CloseProblem, zabbix.Acknowledge), zabbix.WithMessage("acknowledged from CLI"))
+			_, err = z.AcknowledgeEvents(ctx, []string{pb.EventID}, ackOptions...)
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "Warning: failed to acknowledge problem %s: %v\n", pb.Name, err.Error())
+				failed++
+				fmt.Fprintf(os.Stderr, "Failed to acknowledge problem %s (%s): %v\n", pb.EventID, pb.Name, err)
 				continue
 			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Acknowledged problem %s (%s)\n", pb.EventID, pb.Name)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Summary: %d acknowledged, %d failed\n", len(problems)-failed, failed)
+		if failed > 0 {
+			return fmt.Errorf("%d problem(s) could not be acknowledged", failed)
 		}
+		return nil
 	},
 }
+
+func init() {
+	ackFilters.addFlags(ackCmd.Flags())
+	ackCmd.Flags().StringVarP(&ackMessage, "message", "m", "", "Message to add to the acknowledged problems")
+	ackCmd.Flags().BoolVar(&ackClose, "close", false, "Also close the acknowledged problems")
+	ackCmd.Flags().BoolVar(&ackDryRun, "dry-run", false, "List the problems that would be acknowledged without acknowledging them")
+	ackCmd.Flags().BoolVarP(&ackYes, "yes", "y", false, "Do not ask for confirmation")
+	ackCmd.Flags().BoolVarP(&ackInteractive, "interactive", "i", false, "Pick the problems and the actions to apply interactively")
+	ackCmd.Flags().IntVar(&ackConfirmThreshold, "confirm-threshold", defaultConfirmThreshold, "Ask for confirmation when more problems than this are selected")
+}
+
+// ackTriageDefaults returns the triage actions preselected by the ack flags.
+func ackTriageDefaults(message string, closeProblem bool) []string {
+	actions := []string{triageActionAck}
+	if message != "" {
+		actions = append(actions, triageActionMessage)
+	}
+	if closeProblem {
+		actions = append(actions, triageActionClose)
+	}
+	return actions
+}
+
+// warnMissingEvents prints a warning for every requested event ID that is not part of problems.
+func warnMissingEvents(eventIDs []string, problems []zabbix.Problem) {
+	found := make(map[string]bool, len(problems))
+	for _, pb := range problems {
+		found[pb.EventID] = true
+	}
+	for _, id := range eventIDs {
+		if !found[id] {
+			fmt.Fprintf(os.Stderr, "Warning: event %s not found or does not match the filters\n", id)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+)
+
+// newClient creates a Zabbix client from the loaded configuration and logs in.
+// The returned logout function must be deferred by the caller. It uses its own
+// context so that the session is closed even when ctx has been cancelled.
+func newClient(ctx context.Context) (*zabbix.Client, func(), error) {
+	if conf == nil || !conf.IsValid() {
+		return nil, nil, fmt.Errorf("configuration not initialized: %w", ErrInvalidConfig)
+	}
+
+	z := zabbix.New(conf.ZabbixUser, conf.ZabbixPassword, conf.ZabbixEndpoint)
+	if err := z.Login(ctx); err != nil {
+		return nil, nil, fmt.Errorf("login failed: %w", err)
+	}
+
+	logout := func() {
+		if err := z.Logout(context.Background()); err != nil {
+			fmt.Fprintf(os.Stderr, "logout failed: %v\n", err)
+		}
+	}
+	return &z, logout, nil
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// defaultConfirmThreshold is the number of objects above which destructive
+// commands ask for confirmation unless --yes is given.
+const defaultConfirmThreshold = 5
+
+// needsConfirmation returns true if count objects are more than threshold and --yes was not given.
+func needsConfirmation(count, threshold int, yes bool) bool {
+	return count > threshold && !yes
+}
+
+// confirm prints prompt on out and reads the answer from in.
+// Only "y" and "yes" (case-insensitive) are accepted as a positive answer;
+// anything else, including EOF, is a refusal.
+func confirm(in io.Reader, out io.Writer, prompt string) bool {
+	fmt.Fprintf(out, "%s [y/N]: ", prompt)
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Fprintln(out)
+		return false
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// EventCmd represents the event subcommand
+var EventCmd = &cobra.Command{
+	Use:   "event",
+	Short: "update events",
+	Long:  `update events`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		// print help
+		cmd.Help() //nolint:errcheck
+		os.Exit(1)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(EventCmd)
+}
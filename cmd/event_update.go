@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	eventUpdateSeverity      string
+	eventUpdateAck           bool
+	eventUpdateUnack         bool
+	eventUpdateSuppressUntil string
+	eventUpdateUnsuppress    bool
+	eventUpdateMessage       string
+	eventUpdateClose         bool
+)
+
+// suppressIndefinitely is the --suppress-until value that suppresses events without end.
+const suppressIndefinitely = "indefinitely"
+
+// errNoEventAction is returned when an event update does not request any action.
+var errNoEventAction = errors.New("no action requested")
+
+// eventUpdate describes the changes applied to events by a single event.acknowledge call.
+type eventUpdate struct {
+	actions       []zabbix.EventAction
+	message       string
+	severity      zabbix.Severity
+	suppressUntil int64
+}
+
+// action returns the bitmask of the requested actions.
+func (u *eventUpdate) action() zabbix.EventAction {
+	return zabbix.EventAction(zabbix.NewEventAction(u.actions...))
+}
+
+// options returns the event.acknowledge options for the update.
+func (u *eventUpdate) options() []zabbix.EventAcknowledgeRequestOption {
+	options := []zabbix.EventAcknowledgeRequestOption{zabbix.WithActions(u.actions...)}
+	action := u.action()
+	if action&zabbix.AddMessage != 0 {
+		options = append(options, zabbix.WithMessage(u.message))
+	}
+	if action&zabbix.ChangeSeverity != 0 {
+		options = append(options, zabbix.WithSeverity(u.severity))
+	}
+	if action&zabbix.Suppress != 0 {
+		options = append(options, zabbix.WithSuppressUntil(u.suppressUntil))
+	}
+	return options
+}
+
+// describe returns a short human readable description of the update.
+func (u *eventUpdate) describe() string {
+	names := make([]string, 0, len(u.actions))
+	for _, a := range u.actions {
+		switch a {
+		case zabbix.ChangeSeverity:
+			names = append(names, "severity="+u.severity.String())
+		case zabbix.Suppress:
+			if u.suppressUntil == 0 {
+				names = append(names, "suppress indefinitely")
+			} else {
+				names = append(names, "suppress until "+zabbix.FormatTimestamp(u.suppressUntil))
+			}
+		default:
+			names = append(names, a.String())
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// apply validates the update against the server version and sends it for the given events.
+func (u *eventUpdate) apply(ctx context.Context, z *zabbix.Client, eventIDs []string) ([]int, error) {
+	if len(u.actions) == 0 {
+		return nil, errNoEventAction
+	}
+	version, err := z.APIVersion(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get Zabbix version: %w", err)
+	}
+	if err := zabbix.ValidateEventActions(version, u.action()); err != nil {
+		return nil, err
+	}
+	ids, err := z.AcknowledgeEvents(ctx, eventIDs, u.options()...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update events: %w", err)
+	}
+	return ids, nil
+}
+
+// parseSuppressUntil parses a --suppress-until value: an absolute time, a duration from now,
+// or "indefinitely" (returned as 0).
+func parseSuppressUntil(value string, now time.Time) (int64, error) {
+	if strings.EqualFold(strings.TrimSpace(value), suppressIndefinitely) {
+		return 0, nil
+	}
+	until, err := parseTimeOrDuration(value, now)
+	if err != nil {
+		return 0, err
+	}
+	if !until.After(now) {
+		return 0, fmt.Errorf("suppress-until %q is in the past", value)
+	}
+	return until.Unix(), nil
+}
+
+// EventUpdateCmd represents the event update subcommand
+var EventUpdateCmd = &cobra.Command{
+	Use:   "update <eventid...>",
+	Short: "Update events (severity, acknowledgement, suppression, message, close)",
+	Long: `Update one or more events with any combination of the event.acknowledge actions.
+
+Examples:
+  zabbix-cli event update 1234 --severity High --message "escalated"
+  zabbix-cli event update 1234 5678 --suppress-until 2h
+  zabbix-cli event update 1234 --suppress-until "2026-10-20 08:00"
+  zabbix-cli event update 1234 --unsuppress --unack
+
+The requested actions are checked against the Zabbix server version before being sent.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		update, err := eventUpdateFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		ids, err := update.apply(ctx, z, args)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Updated %d event(s): %s\n", len(ids), update.describe())
+		return nil
+	},
+}
+
+// eventUpdateFromFlags builds an eventUpdate from the event update flags.
+func eventUpdateFromFlags(cmd *cobra.Command) (*eventUpdate, error) {
+	if eventUpdateAck && eventUpdateUnack {
+		return nil, fmt.Errorf("--ack and --unack are mutually exclusive")
+	}
+	suppress := cmd.Flags().Changed("suppress-until")
+	if suppress && eventUpdateUnsuppress {
+		return nil, fmt.Errorf("--suppress-until and --unsuppress are mutually exclusive")
+	}
+
+	update := &eventUpdate{message: eventUpdateMessage}
+	if eventUpdateClose {
+		update.actions = append(update.actions, zabbix.CloseProblem)
+	}
+	if eventUpdateAck {
+		update.actions = append(update.actions, zabbix.Acknowledge)
+	}
+	if eventUpdateMessage != "" {
+		update.actions = append(update.actions, zabbix.AddMessage)
+	}
+	if eventUpdateSeverity != "" {
+		severity, err := zabbix.ParseSeverity(eventUpdateSeverity)
+		if err != nil {
+			return nil, err
+		}
+		update.severity = severity
+		update.actions = append(update.actions, zabbix.ChangeSeverity)
+	}
+	if eventUpdateUnack {
+		update.actions = append(update.actions, zabbix.Unacknowledge)
+	}
+	if suppress {
+		until, err := parseSuppressUntil(eventUpdateSuppressUntil, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		update.suppressUntil = until
+		update.actions = append(update.actions, zabbix.Suppress)
+	}
+	if eventUpdateUnsuppress {
+		update.actions = append(update.actions, zabbix.Unsuppress)
+	}
+
+	if len(update.actions) == 0 {
+		return nil, fmt.Errorf("%w: use at least one of --severity, --ack, --unack, --suppress-until, --unsuppress, --message or --close", errNoEventAction)
+	}
+	return update, nil
+}
+
+func init() {
+	EventUpdateCmd.Flags().StringVar(&eventUpdateSeverity, "severity", "", "Change the severity (e.g., 'Warning', 'High' or 0-5)")
+	EventUpdateCmd.Flags().BoolVar(&eventUpdateAck, "ack", false, "Acknowledge the events")
+	EventUpdateCmd.Flags().BoolVar(&eventUpdateUnack, "unack", false, "Unacknowledge the events")
+	EventUpdateCmd.Flags().StringVar(&eventUpdateSuppressUntil, "suppress-until", "", "Suppress the events until a time ('2026-10-20 08:00'), for a duration ('2h', '1d') or 'indefinitely'")
+	EventUpdateCmd.Flags().BoolVar(&eventUpdateUnsuppress, "unsuppress", false, "Unsuppress the events")
+	EventUpdateCmd.Flags().StringVarP(&eventUpdateMessage, "message", "m", "", "Add a message to the events")
+	EventUpdateCmd.Flags().BoolVar(&eventUpdateClose, "close", false, "Close the problems")
+	EventCmd.AddCommand(EventUpdateCmd)
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// HostCmd represents the host command (list, show, create, update, delete ...)
+var HostCmd = &cobra.Command{
+	Use:   "host",
+	Short: "Manage hosts",
+	Long:  `List, show, create, update, delete, enable and disable hosts`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		// print help
+		cmd.Help() //nolint:errcheck
+		os.Exit(1)
+	},
+}
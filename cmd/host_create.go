@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var hostCreateFlags hostFlags
+
+// HostCreateCmd represents the host create command
+var HostCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a host",
+	Long: `Create a host with its host groups, templates, tags and interfaces.
+
+The host is described by flags, or by a YAML spec given with --spec which the flags override.
+Host groups and templates are given by name or glob ('*' wildcard); at least one host group is
+required. Interfaces are given as address[:port], where address is an IP address or a DNS name;
+the first interface of each type is the main one. The --snmp-* flags set the SNMP details of
+the --snmp interfaces.
+
+Spec example:
+  host: sw01
+  name: Switch 01
+  groups: [Network]
+  templates: [Cisco IOS by SNMP]
+  tags:
+    - {tag: site, value: par1}
+  interfaces:
+    - type: snmp
+      address: 10.0.0.2
+      snmp: {version: "3", security_name: zabbix, security_level: authPriv,
+             auth_protocol: sha256, auth_passphrase: secret, priv_protocol: aes256, priv_passphrase: secret}
+
+Examples:
+  zabbix-cli host create web01 --group "Linux servers" --template "Linux by Zabbix agent" --agent 10.0.0.1
+  zabbix-cli host create sw01 --group Network --snmp 10.0.0.2 --snmp-version 2c --snmp-community public
+  zabbix-cli host create --spec sw01.yaml`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		spec, err := hostCreateFlags.spec(cmd)
+		if err != nil {
+			return err
+		}
+		if len(args) > 0 {
+			spec.Host = args[0]
+		}
+		if spec.Host == "" {
+			return fmt.Errorf("no host name given: give it as argument or in the spec")
+		}
+		if len(spec.Groups) == 0 {
+			return fmt.Errorf("at least one host group is required: use --group")
+		}
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		params, err := spec.toHostParams(ctx, z)
+		if err != nil {
+			return err
+		}
+		response, err := z.HostCreate(ctx, zabbix.NewHostCreateRequest(params,
+			zabbix.WithHostCreateAuth(z.Auth()),
+			zabbix.WithHostCreateID(1),
+		))
+		if err != nil {
+			return fmt.Errorf("failed to create host %q: %w", spec.Host, err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Successfully created host %q (ID: %s)\n", spec.Host, response.Result.HostIDs[0])
+		return nil
+	},
+}
+
+func init() {
+	hostCreateFlags.addFlags(HostCreateCmd.Flags())
+}
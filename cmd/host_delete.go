@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	hostDeleteDryRun bool
+	hostDeleteYes    bool
+)
+
+// HostDeleteCmd represents the host delete command
+var HostDeleteCmd = &cobra.Command{
+	Use:   "delete <name...>",
+	Short: "Delete hosts",
+	Long: `Delete hosts given by name or glob ('*' wildcard).
+
+The hosts are listed with the number of items and triggers deleted with them and the
+maintenances covering them, and a confirmation is asked unless --yes is given. Zabbix refuses
+to delete the last host of a maintenance without host groups: such maintenances are marked.
+Use --dry-run to only list them.
+
+Examples:
+  zabbix-cli host delete web01
+  zabbix-cli host delete 'staging-*' --dry-run`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		hosts, err := resolveHosts(ctx, z, args)
+		if err != nil {
+			return err
+		}
+		ids := make([]string, 0, len(hosts))
+		for _, h := range hosts {
+			ids = append(ids, h.HostID)
+		}
+		response, err := z.HostGet(ctx, zabbix.NewHostGetRequest(
+			zabbix.WithHostGetHostIDs(ids),
+			zabbix.WithHostGetOutput([]string{"hostid", "host", "name", "status"}),
+			zabbix.WithHostGetSelectHostGroups([]string{"groupid", "name"}),
+			zabbix.WithHostGetSelectItems("count"),
+			zabbix.WithHostGetSelectTriggers("count"),
+			zabbix.WithHostGetSortField([]string{"host"}),
+			zabbix.WithHostGetAuth(z.Auth()),
+			zabbix.WithHostGetID(1),
+		))
+		if err != nil {
+			return fmt.Errorf("failed to get hosts: %w", err)
+		}
+		hosts = response.Result
+		// The preview only needs the targets of the maintenances, not their periods or tags.
+		maintenances, err := z.MaintenanceGet(ctx, zabbix.NewMaintenanceGetRequest(
+			zabbix.WithMaintenanceGetOutput([]string{"maintenanceid", "name"}),
+			zabbix.WithMaintenanceGetSelectGroups([]string{"groupid", "name"}),
+			zabbix.WithMaintenanceGetSelectHosts([]string{"hostid", "host"}),
+			zabbix.WithMaintenanceGetAuthToken(z.Auth()),
+			zabbix.WithMaintenanceGetID(1),
+		))
+		if err != nil {
+			return fmt.Errorf("failed to get maintenance periods: %w", err)
+		}
+
+		out := cmd.OutOrStdout()
+		printHostDeletePreview(out, hosts, maintenances.Result)
+		if hostDeleteDryRun {
+			fmt.Fprintf(out, "Dry run: %d host(s) would be deleted\n", len(hosts))
+			return nil
+		}
+		if !hostDeleteYes && !confirm(cmd.InOrStdin(), out, fmt.Sprintf("Delete %d host(s)?", len(hosts))) {
+			return fmt.Errorf("aborted by user")
+		}
+
+		deleted, err := z.HostDelete(ctx, zabbix.NewHostDeleteRequest(ids,
+			zabbix.WithHostDeleteAuth(z.Auth()),
+			zabbix.WithHostDeleteID(1),
+		))
+		if err != nil {
+			return fmt.Errorf("failed to delete hosts: %w", err)
+		}
+
+		fmt.Fprintf(out, "Successfully deleted %d host(s)\n", len(deleted.Result.HostIDs))
+		return nil
+	},
+}
+
+func init() {
+	HostDeleteCmd.Flags().BoolVar(&hostDeleteDryRun, "dry-run", false, "List the hosts that would be deleted without deleting them")
+	HostDeleteCmd.Flags().BoolVarP(&hostDeleteYes, "yes", "y", false, "Do not ask for confirmation")
+}
+
+// emptiedMaintenances returns the IDs of the maintenances without host groups whose hosts are
+// all in hosts: Zabbix refuses to delete them as a maintenance must keep a host or a host group.
+func emptiedMaintenances(maintenances []zabbix.Maintenance, hosts []zabbix.Host) map[string]bool {
+	deleted := make(map[string]bool, len(hosts))
+	for _, h := range hosts {
+		deleted[h.HostID] = true
+	}
+	emptied := make(map[string]bool)
+	for _, m := range maintenances {
+		if len(m.Groups) > 0 || len(m.Hosts) == 0 {
+			continue
+		}
+		all := true
+		for _, h := range m.Hosts {
+			all = all && deleted[h.HostID]
+		}
+		if all {
+			emptied[m.MaintenanceID] = true
+		}
+	}
+	return emptied
+}
+
+// printHostDeletePreview prints the hosts about to be deleted with their number of items and
+// triggers and the maintenances covering them.
+func printHostDeletePreview(out io.Writer, hosts []zabbix.Host, maintenances []zabbix.Maintenance) {
+	emptied := emptiedMaintenances(maintenances, hosts)
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "HOSTID\tHOST\tITEMS\tTRIGGERS\tMAINTENANCES")
+	for i := range hosts {
+		h := &hosts[i]
+		var names []string
+		for j := range maintenances {
+			m := &maintenances[j]
+			if maintenanceCoversHost(m, h) {
+				name := m.Name
+				if emptied[m.MaintenanceID] {
+					name += " (last host)"
+				}
+				names = append(names, name)
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n", h.HostID, h.Host, h.ItemCount, h.TriggerCount, strings.Join(names, ", "))
+	}
+	w.Flush()
+}
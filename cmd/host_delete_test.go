@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintHostDeletePreview(t *testing.T) {
+	t.Parallel()
+
+	hosts := []zabbix.Host{
+		{HostID: "1", Host: "web01", ItemCount: 120, TriggerCount: 40, HostGroups: []zabbix.HostGroup{{GroupID: "10"}}},
+		{HostID: "2", Host: "web02", ItemCount: 3},
+	}
+	maintenances := []zabbix.Maintenance{
+		{MaintenanceID: "5", Name: "Patching", Hosts: []zabbix.Host{{HostID: "1"}, {HostID: "2"}}},
+		{MaintenanceID: "6", Name: "Weekly", Groups: []zabbix.HostGroup{{GroupID: "10"}}},
+		{MaintenanceID: "7", Name: "DB", Hosts: []zabbix.Host{{HostID: "2"}, {HostID: "3"}}},
+	}
+
+	var out bytes.Buffer
+	printHostDeletePreview(&out, hosts, maintenances)
+	assert.Equal(t, `HOSTID   HOST    ITEMS   TRIGGERS   MAINTENANCES
+1        web01   120     40         Patching (last host), Weekly
+2        web02   3       0          Patching (last host), DB
+`, out.String())
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	hostStatusDryRun bool
+	hostStatusYes    bool
+)
+
+// HostEnableCmd represents the host enable command
+var HostEnableCmd = &cobra.Command{
+	Use:   "enable <name...>",
+	Short: "Enable (monitor) hosts",
+	Long: `Enable hosts given by name or glob ('*' wildcard), so that Zabbix monitors them.
+
+Hosts already enabled are skipped. A confirmation is asked when more than 5 hosts are changed,
+unless --yes is given.
+
+Examples:
+  zabbix-cli host enable web01 web02
+  zabbix-cli host enable 'web*' --dry-run`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeHostStatus(cmd, args, zabbix.HostStatusMonitored)
+	},
+}
+
+// HostDisableCmd represents the host disable command
+var HostDisableCmd = &cobra.Command{
+	Use:   "disable <name...>",
+	Short: "Disable (stop monitoring) hosts",
+	Long: `Disable hosts given by name or glob ('*' wildcard), so that Zabbix stops monitoring them.
+
+Hosts already disabled are skipped. A confirmation is asked when more than 5 hosts are changed,
+unless --yes is given.
+
+Examples:
+  zabbix-cli host disable web01
+  zabbix-cli host disable 'staging-*' --yes`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeHostStatus(cmd, args, zabbix.HostStatusUnmonitored)
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{HostEnableCmd, HostDisableCmd} {
+		c.Flags().BoolVar(&hostStatusDryRun, "dry-run", false, "List the hosts that would be changed without changing them")
+		c.Flags().BoolVarP(&hostStatusYes, "yes", "y", false, "Do not ask for confirmation")
+	}
+}
+
+// changeHostStatus sets the status of the hosts matching patterns.
+func changeHostStatus(cmd *cobra.Command, patterns []string, status string) error {
+	ctx := context.Background()
+
+	z, logout, err := newClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer logout()
+
+	hosts, err := resolveHosts(ctx, z, patterns)
+	if err != nil {
+		return err
+	}
+	changed, skipped := planHostStatus(hosts, status)
+
+	out := cmd.OutOrStdout()
+	verb, done := "Enable", "enabled"
+	if status == zabbix.HostStatusUnmonitored {
+		verb, done = "Disable", "disabled"
+	}
+	if len(skipped) > 0 {
+		fmt.Fprintf(out, "Skipping %d host(s) already %s\n", len(skipped), done)
+	}
+	if len(changed) == 0 {
+		fmt.Fprintf(out, "No host to %s\n", strings.ToLower(verb))
+		return nil
+	}
+
+	if hostStatusDryRun || len(changed) > defaultConfirmThreshold {
+		printHostList(out, changed)
+	}
+	if hostStatusDryRun {
+		fmt.Fprintf(out, "Dry run: %d host(s) would be %s\n", len(changed), done)
+		return nil
+	}
+	if len(changed) > defaultConfirmThreshold && !hostStatusYes {
+		if !confirm(cmd.InOrStdin(), out, fmt.Sprintf("%s %d hosts?", verb, len(changed))) {
+			return fmt.Errorf("aborted by user")
+		}
+	}
+
+	hostIDs := make([]string, 0, len(changed))
+	for _, h := range changed {
+		hostIDs = append(hostIDs, h.HostID)
+	}
+	_, err = z.HostMassUpdate(ctx, zabbix.NewHostMassUpdateRequest(hostIDs,
+		zabbix.WithHostMassUpdateStatus(status),
+		zabbix.WithHostMassUpdateAuth(z.Auth()),
+		zabbix.WithHostMassUpdateID(1),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to %s hosts: %w", strings.ToLower(verb), err)
+	}
+
+	fmt.Fprintf(out, "Successfully %s %d host(s)\n", done, len(changed))
+	return nil
+}
+
+// planHostStatus splits hosts into the hosts whose status must change and the hosts that
+// already have the given status.
+func planHostStatus(hosts []zabbix.Host, status string) ([]zabbix.Host, []zabbix.Host) {
+	var changed, skipped []zabbix.Host
+	for _, h := range hosts {
+		if h.IsMonitored() == (status == zabbix.HostStatusMonitored) {
+			skipped = append(skipped, h)
+		} else {
+			changed = append(changed, h)
+		}
+	}
+	return changed, skipped
+}
@@ -0,0 +1,366 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Inventory export formats.
+const (
+	inventoryFormatAnsibleYAML = "ansible-yaml"
+	inventoryFormatAnsibleINI  = "ansible-ini"
+	inventoryFormatFileSD      = "file_sd-json"
+)
+
+// mainInterfacePreference is the order in which the main interfaces are used as host address.
+var mainInterfacePreference = []string{
+	zabbix.HostInterfaceTypeAgent,
+	zabbix.HostInterfaceTypeSNMP,
+	zabbix.HostInterfaceTypeJMX,
+	zabbix.HostInterfaceTypeIPMI,
+}
+
+var (
+	hostExportInventoryFormat          string
+	hostExportInventoryFile            string
+	hostExportInventoryGroups          []string
+	hostExportInventoryTags            []string
+	hostExportInventoryTagsEval        string
+	hostExportInventoryIncludeDisabled bool
+	hostExportInventoryTargetPort      string
+)
+
+// HostExportInventoryCmd represents the host export-inventory command
+var HostExportInventoryCmd = &cobra.Command{
+	Use:   "export-inventory",
+	Short: "Export hosts as an Ansible inventory or Prometheus file_sd targets",
+	Long: `Export the monitored hosts as an Ansible inventory (YAML or INI) or as Prometheus file_sd targets.
+
+Ansible inventories have one group per host group (e.g. "Prod/EU/Web" becomes prod_eu_web), and
+the host vars ansible_host (address of the main interface, agent first), zabbix_hostid,
+zabbix_name, zabbix_tag_<tag> for every tag and zabbix_macro_<macro> for every non-secret macro.
+
+Prometheus file_sd targets have one target per host, address:port of its main interface (the
+port can be replaced with --target-port), with the labels zabbix_host, zabbix_name,
+zabbix_groups (comma separated) and zabbix_tag_<tag> for every tag. Hosts without interface
+are left out.
+
+--group and --tag filter the hosts as for 'host list'. Disabled hosts are left out unless
+--include-disabled is given.
+
+Examples:
+  zabbix-cli host export-inventory --format ansible-yaml -f inventory.yaml
+  zabbix-cli host export-inventory --format ansible-ini --group "Prod/*" --tag env=prod
+  zabbix-cli host export-inventory --format file_sd-json --tag exporter=node --target-port 9100`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx := context.Background()
+
+		switch hostExportInventoryFormat {
+		case inventoryFormatAnsibleYAML, inventoryFormatAnsibleINI, inventoryFormatFileSD:
+		default:
+			return fmt.Errorf("invalid --format %q (expected %s, %s or %s)", hostExportInventoryFormat,
+				inventoryFormatAnsibleYAML, inventoryFormatAnsibleINI, inventoryFormatFileSD)
+		}
+		tags, err := parseTags(hostExportInventoryTags)
+		if err != nil {
+			return err
+		}
+		evalType, err := parseHostTagsEvalType(hostExportInventoryTagsEval)
+		if err != nil {
+			return err
+		}
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		options := []zabbix.HostGetOption{
+			zabbix.WithHostGetOutput([]string{"hostid", "host", "name", "status"}),
+			zabbix.WithHostGetSelectHostGroups([]string{"groupid", "name"}),
+			zabbix.WithHostGetSelectInterfaces([]string{"type", "main", "useip", "ip", "dns", "port"}),
+			zabbix.WithHostGetSelectTags("extend"),
+			zabbix.WithHostGetSelectMacros("extend"),
+			zabbix.WithHostGetSortField([]string{"host"}),
+			zabbix.WithHostGetAuth(z.Auth()),
+			zabbix.WithHostGetID(1),
+		}
+		if len(hostExportInventoryGroups) > 0 {
+			groupIDs, err := resolveHostGroupIDs(ctx, z, hostExportInventoryGroups)
+			if err != nil {
+				return err
+			}
+			options = append(options, zabbix.WithHostGetGroupIDs(groupIDs))
+		}
+		if len(tags) > 0 {
+			options = append(options, zabbix.WithHostGetTags(hostTagFilters(tags)), zabbix.WithHostGetEvalType(evalType))
+		}
+		if !hostExportInventoryIncludeDisabled {
+			options = append(options, zabbix.WithHostGetFilter(map[string]any{"status": zabbix.HostStatusMonitored}))
+		}
+
+		response, err := z.HostGet(ctx, zabbix.NewHostGetRequest(options...))
+		if err != nil {
+			return fmt.Errorf("failed to get hosts: %w", err)
+		}
+
+		out := cmd.OutOrStdout()
+		if hostExportInventoryFile != "" {
+			f, err := os.Create(hostExportInventoryFile)
+			if err != nil {
+				return fmt.Errorf("cannot create %s: %w", hostExportInventoryFile, err)
+			}
+			defer f.Close()
+			out = f
+		}
+
+		switch hostExportInventoryFormat {
+		case inventoryFormatAnsibleYAML:
+			err = writeAnsibleYAML(out, response.Result)
+		case inventoryFormatAnsibleINI:
+			err = writeAnsibleINI(out, response.Result)
+		default:
+			var skipped []string
+			skipped, err = writeFileSD(out, response.Result, hostExportInventoryTargetPort)
+			if len(skipped) > 0 {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: host(s) without interface left out: %s\n", strings.Join(skipped, ", "))
+			}
+		}
+		return err
+	},
+}
+
+func init() {
+	HostExportInventoryCmd.Flags().StringVar(&hostExportInventoryFormat, "format", inventoryFormatAnsibleYAML, "Format: ansible-yaml, ansible-ini, file_sd-json")
+	HostExportInventoryCmd.Flags().StringVarP(&hostExportInventoryFile, "file", "f", "", "Output file path (default: stdout)")
+	HostExportInventoryCmd.Flags().StringArrayVar(&hostExportInventoryGroups, "group", nil, "Filter by host group, by name or glob; repeatable")
+	HostExportInventoryCmd.Flags().StringArrayVar(&hostExportInventoryTags, "tag", nil, "Filter by tag (key=value, or key for any value); repeatable")
+	HostExportInventoryCmd.Flags().StringVar(&hostExportInventoryTagsEval, "tags-eval", "and", "How tags are combined: and, or")
+	HostExportInventoryCmd.Flags().BoolVar(&hostExportInventoryIncludeDisabled, "include-disabled", false, "Include disabled hosts")
+	HostExportInventoryCmd.Flags().StringVar(&hostExportInventoryTargetPort, "target-port", "", "Port of the file_sd targets (default: the port of the interface)")
+}
+
+// inventoryName converts a host group, tag or macro name to an Ansible group, variable or
+// Prometheus label name: lower case letters, digits and underscores, not starting with a digit.
+func inventoryName(name string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			underscore = false
+		} else if !underscore && b.Len() > 0 {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+	result := strings.TrimSuffix(b.String(), "_")
+	if result == "" || (result[0] >= '0' && result[0] <= '9') {
+		result = "_" + result
+	}
+	return result
+}
+
+// macroVarName converts a macro name, e.g. {$SNMP_COMMUNITY}, to a host var name.
+func macroVarName(macro string) string {
+	return "zabbix_macro_" + inventoryName(strings.TrimSuffix(strings.TrimPrefix(macro, "{$"), "}"))
+}
+
+// mainInterface returns the main interface of a host, agent first, or nil if it has none.
+func mainInterface(h *zabbix.Host) *zabbix.HostInterface {
+	for _, typ := range mainInterfacePreference {
+		for i := range h.Interfaces {
+			if h.Interfaces[i].Type == typ && h.Interfaces[i].IsMain() {
+				return &h.Interfaces[i]
+			}
+		}
+	}
+	return nil
+}
+
+// interfaceHost returns the IP address or the DNS name used to connect to an interface.
+func interfaceHost(i *zabbix.HostInterface) string {
+	if i.UseIP == "0" {
+		return i.DNS
+	}
+	return i.IP
+}
+
+// ansibleHostVars returns the Ansible host vars of a host.
+func ansibleHostVars(h *zabbix.Host) map[string]string {
+	vars := map[string]string{
+		"zabbix_hostid": h.HostID,
+		"zabbix_name":   h.DisplayName(),
+	}
+	if i := mainInterface(h); i != nil {
+		vars["ansible_host"] = interfaceHost(i)
+	}
+	for _, t := range h.Tags {
+		vars["zabbix_tag_"+inventoryName(t.Tag)] = t.Value
+	}
+	for _, m := range h.Macros {
+		if m.Type == zabbix.UserMacroTypeText || m.Type == "" {
+			vars[macroVarName(m.Macro)] = m.Value
+		}
+	}
+	return vars
+}
+
+// ansibleGroups returns the hosts of every Ansible group, by group name.
+func ansibleGroups(hosts []zabbix.Host) map[string][]string {
+	groups := make(map[string][]string)
+	for _, h := range hosts {
+		for _, g := range h.HostGroups {
+			name := inventoryName(g.Name)
+			groups[name] = append(groups[name], h.Host)
+		}
+	}
+	return groups
+}
+
+// writeAnsibleYAML writes hosts as an Ansible YAML inventory: the host vars under all.hosts and
+// one child group per host group.
+func writeAnsibleYAML(out io.Writer, hosts []zabbix.Host) error {
+	allHosts := make(map[string]map[string]string, len(hosts))
+	for i := range hosts {
+		allHosts[hosts[i].Host] = ansibleHostVars(&hosts[i])
+	}
+	children := make(map[string]any)
+	for name, members := range ansibleGroups(hosts) {
+		groupHosts := make(map[string]any, len(members))
+		for _, h := range members {
+			groupHosts[h] = nil
+		}
+		children[name] = map[string]any{"hosts": groupHosts}
+	}
+	inventory := map[string]any{"all": map[string]any{"hosts": allHosts, "children": children}}
+
+	encoder := yaml.NewEncoder(out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(inventory); err != nil {
+		return fmt.Errorf("failed to marshal inventory to YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to marshal inventory to YAML: %w", err)
+	}
+	return nil
+}
+
+// writeAnsibleINI writes hosts as an Ansible INI inventory: one section per host group, the host
+// vars being set on the first line of each host.
+func writeAnsibleINI(out io.Writer, hosts []zabbix.Host) error {
+	vars := make(map[string]map[string]string, len(hosts))
+	for i := range hosts {
+		vars[hosts[i].Host] = ansibleHostVars(&hosts[i])
+	}
+	writeHost := func(w io.Writer, host string) {
+		v, ok := vars[host]
+		if !ok {
+			fmt.Fprintln(w, host)
+			return
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		line := host
+		for _, k := range keys {
+			line += " " + k + "=" + iniValue(v[k])
+		}
+		fmt.Fprintln(w, line)
+		delete(vars, host)
+	}
+
+	var ungrouped []string
+	for _, h := range hosts {
+		if len(h.HostGroups) == 0 {
+			ungrouped = append(ungrouped, h.Host)
+		}
+	}
+	for _, h := range ungrouped {
+		writeHost(out, h)
+	}
+
+	groups := ansibleGroups(hosts)
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		if i > 0 || len(ungrouped) > 0 {
+			fmt.Fprintln(out)
+		}
+		fmt.Fprintf(out, "[%s]\n", name)
+		for _, h := range groups[name] {
+			writeHost(out, h)
+		}
+	}
+	return nil
+}
+
+// iniValue quotes a host var value of an INI inventory when it is empty or contains spaces,
+// quotes or '#'.
+func iniValue(value string) string {
+	if value == "" || strings.ContainsAny(value, " \t\"'#=") {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+// fileSDTargetGroup is a Prometheus file_sd target group.
+type fileSDTargetGroup struct {
+	Targets []string          `json:"targets"`
+	Labels  map[string]string `json:"labels"`
+}
+
+// writeFileSD writes hosts as Prometheus file_sd targets, one target group per host, and returns
+// the hosts left out because they have no interface. The port of the targets is port, or the
+// port of the interface if empty.
+func writeFileSD(out io.Writer, hosts []zabbix.Host, port string) ([]string, error) {
+	groups := make([]fileSDTargetGroup, 0, len(hosts))
+	var skipped []string
+	for i := range hosts {
+		h := &hosts[i]
+		iface := mainInterface(h)
+		if iface == nil {
+			skipped = append(skipped, h.Host)
+			continue
+		}
+		names := make([]string, 0, len(h.HostGroups))
+		for _, g := range h.HostGroups {
+			names = append(names, g.Name)
+		}
+		labels := map[string]string{
+			"zabbix_host":   h.Host,
+			"zabbix_name":   h.DisplayName(),
+			"zabbix_groups": "," + strings.Join(names, ",") + ",",
+		}
+		for _, t := range h.Tags {
+			labels["zabbix_tag_"+inventoryName(t.Tag)] = t.Value
+		}
+		target := net.JoinHostPort(interfaceHost(iface), defaultString(port, iface.Port))
+		groups = append(groups, fileSDTargetGroup{Targets: []string{target}, Labels: labels})
+	}
+
+	jsonOutput, err := json.MarshalIndent(groups, "", "  ")
+	if err != nil {
+		return skipped, fmt.Errorf("failed to marshal targets to JSON: %w", err)
+	}
+	fmt.Fprintln(out, string(jsonOutput))
+	return skipped, nil
+}
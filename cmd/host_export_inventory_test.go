@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// inventoryTestHosts returns hosts as read by host export-inventory.
+func inventoryTestHosts() []zabbix.Host {
+	return []zabbix.Host{
+		{
+			HostID: "1", Host: "web01", Name: "Web 01",
+			HostGroups: []zabbix.HostGroup{{Name: "Linux servers"}, {Name: "Prod/EU/Web"}},
+			Interfaces: []zabbix.HostInterface{
+				{Type: zabbix.HostInterfaceTypeSNMP, Main: "1", UseIP: "1", IP: "10.0.1.1", Port: "161"},
+				{Type: zabbix.HostInterfaceTypeAgent, Main: "1", UseIP: "1", IP: "10.0.0.1", Port: "10050"},
+			},
+			Tags:   []zabbix.HostTag{{Tag: "env", Value: "prod"}},
+			Macros: []zabbix.UserMacro{{Macro: "{$NGINX.PORT}", Value: "8080"}, {Macro: "{$PASS}", Type: zabbix.UserMacroTypeSecret}},
+		},
+		{
+			HostID: "2", Host: "db01",
+			HostGroups: []zabbix.HostGroup{{Name: "Linux servers"}},
+			Interfaces: []zabbix.HostInterface{{Type: zabbix.HostInterfaceTypeAgent, Main: "1", UseIP: "0", DNS: "db01.example.com", Port: "10050"}},
+			Tags:       []zabbix.HostTag{{Tag: "team", Value: "data platform"}},
+		},
+		{HostID: "3", Host: "sensor01"},
+	}
+}
+
+func TestInventoryName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "linux_servers", inventoryName("Linux servers"))
+	assert.Equal(t, "prod_eu_web", inventoryName("Prod/EU/Web"))
+	assert.Equal(t, "_2nd_floor", inventoryName("2nd floor"))
+	assert.Equal(t, "zabbix_macro_nginx_port", macroVarName("{$NGINX.PORT}"))
+}
+
+func TestWriteAnsibleYAML(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	require.NoError(t, writeAnsibleYAML(&out, inventoryTestHosts()))
+	assert.Equal(t, `all:
+  children:
+    linux_servers:
+      hosts:
+        db01: null
+        web01: null
+    prod_eu_web:
+      hosts:
+        web01: null
+  hosts:
+    db01:
+      ansible_host: db01.example.com
+      zabbix_hostid: "2"
+      zabbix_name: db01
+      zabbix_tag_team: data platform
+    sensor01:
+      zabbix_hostid: "3"
+      zabbix_name: sensor01
+    web01:
+      ansible_host: 10.0.0.1
+      zabbix_hostid: "1"
+      zabbix_macro_nginx_port: "8080"
+      zabbix_name: Web 01
+      zabbix_tag_env: prod
+`, out.String())
+}
+
+func TestWriteAnsibleINI(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	require.NoError(t, writeAnsibleINI(&out, inventoryTestHosts()))
+	assert.Equal(t, `sensor01 zabbix_hostid=3 zabbix_name=sensor01
+
+[linux_servers]
+web01 ansible_host=10.0.0.1 zabbix_hostid=1 zabbix_macro_nginx_port=8080 zabbix_name="Web 01" zabbix_tag_env=prod
+db01 ansible_host=db01.example.com zabbix_hostid=2 zabbix_name=db01 zabbix_tag_team="data platform"
+
+[prod_eu_web]
+web01
+`, out.String())
+}
+
+func TestWriteFileSD(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	skipped, err := writeFileSD(&out, inventoryTestHosts()[:2], "9100")
+	require.NoError(t, err)
+	assert.Empty(t, skipped)
+	assert.JSONEq(t, `[
+		{"targets":["10.0.0.1:9100"],"labels":{"zabbix_host":"web01","zabbix_name":"Web 01","zabbix_groups":",Linux servers,Prod/EU/Web,","zabbix_tag_env":"prod"}},
+		{"targets":["db01.example.com:9100"],"labels":{"zabbix_host":"db01","zabbix_name":"db01","zabbix_groups":",Linux servers,","zabbix_tag_team":"data platform"}}
+	]`, out.String())
+
+	out.Reset()
+	skipped, err = writeFileSD(&out, inventoryTestHosts(), "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sensor01"}, skipped)
+	assert.Contains(t, out.String(), `"10.0.0.1:10050"`)
+}
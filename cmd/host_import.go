@@ -0,0 +1,496 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Actions of host import on existing hosts.
+const (
+	hostImportSkip   = "skip"
+	hostImportUpdate = "update"
+	hostImportCreate = "create"
+)
+
+// hostImportListSeparator separates the values of the list columns of CSV files.
+const hostImportListSeparator = ";"
+
+// hostImportColumns are the columns accepted in CSV files.
+var hostImportColumns = map[string]bool{
+	"host": true, "name": true, "description": true, "status": true, "address": true,
+	"groups": true, "templates": true, "tags": true, "macros": true,
+}
+
+var (
+	hostImportFile         string
+	hostImportFormat       string
+	hostImportExisting     string
+	hostImportCreateGroups bool
+	hostImportDryRun       bool
+)
+
+// hostImportDocument is the YAML document read by 'host import'.
+type hostImportDocument struct {
+	Hosts []hostSpec `yaml:"hosts"`
+}
+
+// hostImportRow is a host read from an inventory file.
+type hostImportRow struct {
+	Row    int               // Line of the host in a CSV file, or position in the hosts of a YAML file, from 1.
+	Spec   hostSpec          // Host read from the row.
+	Params zabbix.HostParams // Parameters of the host, set once the row is validated and resolved.
+	Action string            // create, update or skip.
+	Err    error             // Error of the row, if it failed.
+}
+
+// HostImportCmd represents the host import command
+var HostImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Create or update hosts from a CSV or YAML inventory file",
+	Long: `Create or update hosts in bulk from a CSV or YAML inventory file ("-" for stdin).
+
+CSV files have a header row naming their columns: host (required), name, description, status,
+address, groups, templates, tags and macros. The address is the IP address or DNS name of the
+agent interface, with an optional port (address[:port]). Lists are separated by ';': groups and
+templates by exact name (no wildcards), tags as key=value, macros as {$MACRO}=value. Errors
+give the line of the host in the file.
+
+YAML files hold a 'hosts' list of host specs, as read by 'host create --spec', which can also
+describe SNMP, IPMI and JMX interfaces.
+
+Every row is validated and every host group and template name is resolved before any change.
+Missing host groups are an error unless --create-groups is given. Hosts that already exist, by
+technical name, are skipped unless --existing update is given: their host groups, templates,
+tags, macros and interfaces are then replaced by the ones of the file, when given. A report
+gives the result of every row.
+
+Examples:
+  zabbix-cli host import -f hosts.csv --dry-run
+  zabbix-cli host import -f hosts.yaml --create-groups --existing update
+
+CSV example:
+  host,name,address,groups,templates,tags,macros
+  web01,Web 01,10.0.0.1,Linux servers;Web,Linux by Zabbix agent,env=prod;team=web,{$NGINX_PORT}=8080`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx := context.Background()
+
+		if hostImportExisting != hostImportSkip && hostImportExisting != hostImportUpdate {
+			return fmt.Errorf("invalid --existing %q (expected '%s' or '%s')", hostImportExisting, hostImportSkip, hostImportUpdate)
+		}
+		rows, err := readHostImport(hostImportFile, hostImportFormat, cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
+		if err := validateHostImport(rows); err != nil {
+			return err
+		}
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		out := cmd.OutOrStdout()
+		if err := resolveHostImport(ctx, out, z, rows); err != nil {
+			return err
+		}
+		if hostImportDryRun {
+			printHostImportReport(out, rows, true)
+			return nil
+		}
+
+		for i := range rows {
+			rows[i].Err = importHost(ctx, z, &rows[i])
+		}
+		printHostImportReport(out, rows, false)
+		failed := 0
+		for _, r := range rows {
+			if r.Err != nil {
+				failed++
+			}
+		}
+		if failed > 0 {
+			return fmt.Errorf("%d of %d host(s) failed", failed, len(rows))
+		}
+		return nil
+	},
+}
+
+func init() {
+	HostImportCmd.Flags().StringVarP(&hostImportFile, "file", "f", "", "Inventory file, CSV or YAML (\"-\" for stdin)")
+	HostImportCmd.Flags().StringVar(&hostImportFormat, "format", "", "File format: csv or yaml (default: from the file extension)")
+	HostImportCmd.Flags().StringVar(&hostImportExisting, "existing", hostImportSkip, "What to do with hosts that already exist: skip, update")
+	HostImportCmd.Flags().BoolVar(&hostImportCreateGroups, "create-groups", false, "Create the missing host groups")
+	HostImportCmd.Flags().BoolVar(&hostImportDryRun, "dry-run", false, "Validate the file and report what would be done without changing anything")
+	HostImportCmd.MarkFlagRequired("file") //nolint:errcheck
+}
+
+// readHostImport reads the hosts of an inventory file ("-" for stdin). The format is csv or
+// yaml, or guessed from the file extension when empty.
+func readHostImport(path, format string, stdin io.Reader) ([]hostImportRow, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			format = "csv"
+		case ".yaml", ".yml":
+			format = "yaml"
+		default:
+			return nil, fmt.Errorf("cannot guess the format of %s, use --format", path)
+		}
+	}
+
+	var r io.Reader = stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read %s: %w", path, err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	switch strings.ToLower(format) {
+	case "csv":
+		return readHostImportCSV(path, r)
+	case "yaml", "yml":
+		var doc hostImportDocument
+		decoder := yaml.NewDecoder(r)
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&doc); err != nil && err != io.EOF {
+			return nil, fmt.Errorf("cannot parse %s: %w", path, err)
+		}
+		rows := make([]hostImportRow, 0, len(doc.Hosts))
+		for i, spec := range doc.Hosts {
+			rows = append(rows, hostImportRow{Row: i + 1, Spec: spec})
+		}
+		return rows, nil
+	default:
+		return nil, fmt.Errorf("invalid format %q (expected 'csv' or 'yaml')", format)
+	}
+}
+
+// readHostImportCSV reads the hosts of a CSV file with a header row.
+func readHostImportCSV(path string, r io.Reader) ([]hostImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", path, err)
+	}
+	for i, column := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+		if !hostImportColumns[header[i]] {
+			return nil, fmt.Errorf("%s: unknown column %q", path, column)
+		}
+	}
+
+	var rows []hostImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %s: %w", path, err)
+		}
+		line, _ := reader.FieldPos(0)
+		var spec hostSpec
+		for j, value := range record {
+			value = strings.TrimSpace(value)
+			switch header[j] {
+			case "host":
+				spec.Host = value
+			case "name":
+				spec.Name = value
+			case "description":
+				spec.Description = value
+			case "status":
+				spec.Status = value
+			case "address":
+				if value != "" {
+					address, port := splitAddressPort(value)
+					spec.Interfaces = []interfaceSpec{{Type: "agent", Address: address, Port: port}}
+				}
+			case "groups":
+				spec.Groups = splitList(value)
+			case "templates":
+				spec.Templates = splitList(value)
+			case "tags":
+				for _, tag := range splitList(value) {
+					key, val, _ := strings.Cut(tag, "=")
+					spec.Tags = append(spec.Tags, hostTagSpec{Tag: strings.TrimSpace(key), Value: strings.TrimSpace(val)})
+				}
+			case "macros":
+				for _, macro := range splitList(value) {
+					key, val, _ := strings.Cut(macro, "=")
+					spec.Macros = append(spec.Macros, macroSpec{Macro: strings.TrimSpace(key), Value: strings.TrimSpace(val)})
+				}
+			}
+		}
+		rows = append(rows, hostImportRow{Row: line, Spec: spec})
+	}
+	return rows, nil
+}
+
+// splitList splits a list column on ';', dropping empty values.
+func splitList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, hostImportListSeparator) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// validateHostImport checks every row and sets their parameters, except for the host groups and
+// templates. It returns the errors of every invalid row.
+func validateHostImport(rows []hostImportRow) error {
+	var errs []error
+	seen := make(map[string]int, len(rows))
+	for i := range rows {
+		r := &rows[i]
+		fail := func(format string, args ...any) {
+			errs = append(errs, fmt.Errorf("row %d: %s", r.Row, fmt.Sprintf(format, args...)))
+		}
+		if r.Spec.Host == "" {
+			fail("no host name")
+			continue
+		}
+		if row, ok := seen[r.Spec.Host]; ok {
+			fail("duplicate host %q (row %d)", r.Spec.Host, row)
+			continue
+		}
+		seen[r.Spec.Host] = r.Row
+		if len(r.Spec.Groups) == 0 {
+			fail("host %q has no host group", r.Spec.Host)
+			continue
+		}
+		for _, name := range r.Spec.Groups {
+			if isGlob(name) {
+				fail("host %q: host group %q: wildcards are not supported, use the exact host group name", r.Spec.Host, name)
+			}
+		}
+		for _, name := range r.Spec.Templates {
+			if isGlob(name) {
+				fail("host %q: template %q: wildcards are not supported, use the exact template name", r.Spec.Host, name)
+			}
+		}
+		for _, t := range r.Spec.Tags {
+			if t.Tag == "" {
+				fail("host %q has a tag without name", r.Spec.Host)
+			}
+		}
+		for _, m := range r.Spec.Macros {
+			if !strings.HasPrefix(m.Macro, "{$") || !strings.HasSuffix(m.Macro, "}") {
+				fail("host %q: invalid macro %q (expected {$MACRO})", r.Spec.Host, m.Macro)
+			}
+		}
+		params, err := r.Spec.hostParams()
+		if err != nil {
+			fail("host %q: %v", r.Spec.Host, err)
+			continue
+		}
+		r.Params = params
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid inventory, nothing was changed:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// resolveHostImport resolves, once for all rows, the host groups (creating the missing ones with
+// --create-groups), the templates and the existing hosts, and sets the action of every row.
+func resolveHostImport(ctx context.Context, out io.Writer, z *zabbix.Client, rows []hostImportRow) error {
+	var groupNames, templateNames, hostNames []string
+	for _, r := range rows {
+		groupNames = append(groupNames, r.Spec.Groups...)
+		templateNames = append(templateNames, r.Spec.Templates...)
+		hostNames = append(hostNames, r.Spec.Host)
+	}
+
+	groupIDs, err := hostGroupIDsByName(ctx, out, z, uniqueSorted(groupNames), hostImportCreateGroups, hostImportDryRun)
+	if err != nil {
+		return err
+	}
+	templateIDs := make(map[string]string)
+	if names := uniqueSorted(templateNames); len(names) > 0 {
+		templates, err := resolveTemplates(ctx, z, names)
+		if err != nil {
+			return err
+		}
+		for _, t := range templates {
+			templateIDs[t.Host] = t.TemplateID
+			templateIDs[t.Name] = t.TemplateID
+		}
+	}
+
+	response, err := z.HostGet(ctx, zabbix.NewHostGetRequest(
+		zabbix.WithHostGetOutput([]string{"hostid", "host"}),
+		zabbix.WithHostGetFilter(map[string]any{"host": hostNames}),
+		zabbix.WithHostGetSelectInterfaces([]string{"interfaceid", "type"}),
+		zabbix.WithHostGetAuth(z.Auth()),
+		zabbix.WithHostGetID(1),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to get hosts: %w", err)
+	}
+	existing := make(map[string]*zabbix.Host, len(response.Result))
+	for i := range response.Result {
+		existing[response.Result[i].Host] = &response.Result[i]
+	}
+
+	for i := range rows {
+		r := &rows[i]
+		for _, name := range r.Spec.Groups {
+			// Missing groups are only possible in dry run with --create-groups.
+			r.Params.Groups = append(r.Params.Groups, zabbix.HostGroupID{GroupID: groupIDs[name]})
+		}
+		for _, name := range r.Spec.Templates {
+			r.Params.Templates = append(r.Params.Templates, zabbix.TemplateID{TemplateID: templateIDs[name]})
+		}
+		r.Action = hostImportCreate
+		if h, ok := existing[r.Spec.Host]; ok {
+			r.Action = hostImportExisting
+			r.Params.HostID = h.HostID
+			keepInterfaceIDs(r.Params.Interfaces, h.Interfaces)
+		}
+	}
+	return nil
+}
+
+// hostGroupIDsByName returns the IDs of the host groups with the given names. Missing host groups
+// are created if create is true (only listed in dry run), reported as missing otherwise.
+func hostGroupIDsByName(ctx context.Context, out io.Writer, z *zabbix.Client, names []string, create, dryRun bool) (map[string]string, error) {
+	ids := make(map[string]string, len(names))
+	if len(names) == 0 {
+		return ids, nil
+	}
+	response, err := z.HostGroupGet(ctx, zabbix.NewGetAllHostGroupsRequest(
+		zabbix.WithHostGroupGetFilter(map[string]any{"name": names}),
+		zabbix.WithHostGroupGetAuth(z.Auth()),
+		zabbix.WithHostGroupGetID(1),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host groups: %w", err)
+	}
+	for _, g := range response.Result {
+		ids[g.Name] = g.GroupID
+	}
+
+	var missing []string
+	for _, name := range names {
+		if _, ok := ids[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) == 0 {
+		return ids, nil
+	}
+	if !create {
+		return nil, fmt.Errorf("host group(s) %s: %w (use --create-groups to create them)", strings.Join(missing, ", "), errNotFound)
+	}
+	if dryRun {
+		fmt.Fprintf(out, "Host group(s) to create: %s\n", strings.Join(missing, ", "))
+		return ids, nil
+	}
+
+	created, err := z.HostGroupCreate(ctx, zabbix.NewHostGroupCreateRequest(missing,
+		zabbix.WithHostGroupCreateAuth(z.Auth()),
+		zabbix.WithHostGroupCreateID(1),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create host groups: %w", err)
+	}
+	for i, id := range created.Result.GroupIDs {
+		ids[missing[i]] = id
+		fmt.Fprintf(out, "Created host group %q (ID: %s)\n", missing[i], id)
+	}
+	return ids, nil
+}
+
+// uniqueSorted returns the sorted distinct values.
+func uniqueSorted(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var unique []string
+	for _, v := range values {
+		if !seen[v] {
+			unique = append(unique, v)
+		}
+		seen[v] = true
+	}
+	sort.Strings(unique)
+	return unique
+}
+
+// importHost creates or updates the host of a row, according to its action.
+func importHost(ctx context.Context, z *zabbix.Client, r *hostImportRow) error {
+	switch r.Action {
+	case hostImportCreate:
+		_, err := z.HostCreate(ctx, zabbix.NewHostCreateRequest(r.Params,
+			zabbix.WithHostCreateAuth(z.Auth()),
+			zabbix.WithHostCreateID(1),
+		))
+		if err != nil {
+			return fmt.Errorf("failed to create host: %w", err)
+		}
+	case hostImportUpdate:
+		params := r.Params
+		params.Host = ""
+		_, err := z.HostUpdate(ctx, zabbix.NewHostUpdateRequest(params,
+			zabbix.WithHostUpdateAuth(z.Auth()),
+			zabbix.WithHostUpdateID(1),
+		))
+		if err != nil {
+			return fmt.Errorf("failed to update host: %w", err)
+		}
+	}
+	return nil
+}
+
+// printHostImportReport prints the action and result of every row.
+func printHostImportReport(out io.Writer, rows []hostImportRow, dryRun bool) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "ROW\tHOST\tACTION\tRESULT")
+	counts := make(map[string]int)
+	for _, r := range rows {
+		result := "ok"
+		switch {
+		case dryRun:
+			result = "dry run"
+		case r.Err != nil:
+			result = "failed: " + r.Err.Error()
+			counts["failed"]++
+		default:
+			counts[r.Action]++
+			if r.Action == hostImportSkip {
+				result = "already exists"
+			}
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", r.Row, r.Spec.Host, r.Action, result)
+	}
+	w.Flush()
+	if dryRun {
+		fmt.Fprintf(out, "Dry run: %d host(s) checked\n", len(rows))
+		return
+	}
+	fmt.Fprintf(out, "Created: %d, updated: %d, skipped: %d, failed: %d\n",
+		counts[hostImportCreate], counts[hostImportUpdate], counts[hostImportSkip], counts["failed"])
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadHostImportCSV(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "hosts.csv")
+	require.NoError(t, os.WriteFile(path, []byte(`host,name,address,groups,templates,tags,macros
+web01,Web 01,10.0.0.1,Linux servers; Web,Linux by Zabbix agent,env=prod;team=web,{$NGINX_PORT}=8080
+db01,,db01.example.com:10051,Databases,,,
+`), 0o600))
+
+	rows, err := readHostImport(path, "", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, hostSpec{
+		Host:       "web01",
+		Name:       "Web 01",
+		Groups:     []string{"Linux servers", "Web"},
+		Templates:  []string{"Linux by Zabbix agent"},
+		Tags:       []hostTagSpec{{Tag: "env", Value: "prod"}, {Tag: "team", Value: "web"}},
+		Interfaces: []interfaceSpec{{Type: "agent", Address: "10.0.0.1"}},
+		Macros:     []macroSpec{{Macro: "{$NGINX_PORT}", Value: "8080"}},
+	}, rows[0].Spec)
+	// Rows are numbered by line, the header being line 1.
+	assert.Equal(t, 3, rows[1].Row)
+	assert.Equal(t, []interfaceSpec{{Type: "agent", Address: "db01.example.com", Port: "10051"}}, rows[1].Spec.Interfaces)
+
+	_, err = readHostImport("-", "csv", strings.NewReader("host,site\nweb01,par1\n"))
+	assert.ErrorContains(t, err, `unknown column "site"`)
+	_, err = readHostImport("hosts.txt", "", nil)
+	assert.ErrorContains(t, err, "use --format")
+}
+
+func TestReadHostImportYAML(t *testing.T) {
+	t.Parallel()
+
+	rows, err := readHostImport("-", "yaml", strings.NewReader(`hosts:
+  - host: sw01
+    groups: [Network]
+    interfaces:
+      - {type: snmp, address: 10.0.0.2, snmp: {version: 2c, community: public}}
+  - host: web01
+    groups: [Web]
+`))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "sw01", rows[0].Spec.Host)
+	assert.Equal(t, "public", rows[0].Spec.Interfaces[0].SNMP.Community)
+	assert.Equal(t, 2, rows[1].Row)
+}
+
+func TestValidateHostImport(t *testing.T) {
+	t.Parallel()
+
+	rows := []hostImportRow{
+		{Row: 1, Spec: hostSpec{Host: "web01", Groups: []string{"Web"}, Interfaces: []interfaceSpec{{Type: "agent", Address: "10.0.0.1"}}}},
+		{Row: 2, Spec: hostSpec{Host: "web01", Groups: []string{"Web"}}},
+		{Row: 3, Spec: hostSpec{Groups: []string{"Web"}}},
+		{Row: 4, Spec: hostSpec{Host: "web04"}},
+		{Row: 5, Spec: hostSpec{Host: "web05", Groups: []string{"Web"}, Macros: []macroSpec{{Macro: "PORT"}}}},
+		{Row: 6, Spec: hostSpec{Host: "web06", Groups: []string{"Web"}, Status: "paused"}},
+		{Row: 7, Spec: hostSpec{Host: "web07", Groups: []string{"Web"}, Templates: []string{"Linux*"}}},
+		{Row: 8, Spec: hostSpec{Host: "web08", Groups: []string{"Prod/*"}}},
+	}
+	err := validateHostImport(rows)
+	require.Error(t, err)
+	for _, msg := range []string{
+		`row 2: duplicate host "web01" (row 1)`,
+		"row 3: no host name",
+		`row 4: host "web04" has no host group`,
+		`row 5: host "web05": invalid macro "PORT"`,
+		`row 6: host "web06": invalid status "paused"`,
+		`row 7: host "web07": template "Linux*": wildcards are not supported`,
+		`row 8: host "web08": host group "Prod/*": wildcards are not supported`,
+	} {
+		assert.Contains(t, err.Error(), msg)
+	}
+	assert.NotContains(t, err.Error(), "row 1:")
+	assert.Equal(t, "10.0.0.1", rows[0].Params.Interfaces[0].IP)
+}
+
+func TestPrintHostImportReport(t *testing.T) {
+	t.Parallel()
+
+	rows := []hostImportRow{
+		{Row: 1, Spec: hostSpec{Host: "web01"}, Action: hostImportCreate},
+		{Row: 2, Spec: hostSpec{Host: "web02"}, Action: hostImportSkip},
+		{Row: 3, Spec: hostSpec{Host: "web03"}, Action: hostImportUpdate, Err: errors.New("failed to update host: no permissions")},
+	}
+	var out bytes.Buffer
+	printHostImportReport(&out, rows, false)
+	assert.Equal(t, `ROW   HOST    ACTION   RESULT
+1     web01   create   ok
+2     web02   skip     already exists
+3     web03   update   failed: failed to update host: no permissions
+Created: 1, updated: 0, skipped: 1, failed: 1
+`, out.String())
+}
+
+func TestUniqueSorted(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"DB", "Web"}, uniqueSorted([]string{"Web", "DB", "Web"}))
+	assert.Nil(t, uniqueSorted(nil))
+}
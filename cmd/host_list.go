@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+// Host tag filter operators of host.get.
+const (
+	hostTagOperatorEquals = 1
+	hostTagOperatorExists = 4
+)
+
+// Host tag evaluation types of host.get. They differ from the ones of maintenances.
+const (
+	hostTagsEvalAndOr = 0
+	hostTagsEvalOr    = 2
+)
+
+// Host availabilities, from the availability of their interfaces.
+const (
+	hostAvailable   = "available"
+	hostUnavailable = "unavailable"
+	hostUnknown     = "unknown"
+)
+
+var (
+	hostListGroups       []string
+	hostListTemplates    []string
+	hostListTags         []string
+	hostListTagsEval     string
+	hostListStatus       string
+	hostListName         string
+	hostListAvailability string
+	hostListLimit        int
+	hostListOutput       string
+)
+
+// HostListCmd represents the host list command
+var HostListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List hosts",
+	Long: `List hosts with optional filtering.
+
+--group and --template take names or globs ('*' wildcard) and keep the hosts in one of the groups
+or linked to one of the templates. --tag takes "key=value" (or "key" for any value) and can be
+repeated; --tags-eval sets whether all (and) or any (or) of the tags must match. --name matches
+the technical or visible name ('*' wildcard). --availability keeps the hosts whose interfaces are
+available, unavailable (at least one interface is unavailable) or unknown.
+
+Examples:
+  zabbix-cli host list --group "Linux servers" --status enabled
+  zabbix-cli host list --name 'web*' --tag env=prod --availability unavailable
+  zabbix-cli host list --template "Linux by Zabbix agent" -o json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx := context.Background()
+
+		switch hostListAvailability {
+		case "", hostAvailable, hostUnavailable, hostUnknown:
+		default:
+			return fmt.Errorf("invalid --availability %q (expected '%s', '%s' or '%s')", hostListAvailability, hostAvailable, hostUnavailable, hostUnknown)
+		}
+		status, err := parseHostStatus(hostListStatus)
+		if err != nil {
+			return err
+		}
+		tags, err := parseTags(hostListTags)
+		if err != nil {
+			return err
+		}
+		evalType, err := parseHostTagsEvalType(hostListTagsEval)
+		if err != nil {
+			return err
+		}
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		options := []zabbix.HostGetOption{
+			zabbix.WithHostGetOutput([]string{"hostid", "host", "name", "status", "maintenance_status"}),
+			zabbix.WithHostGetSelectHostGroups([]string{"groupid", "name"}),
+			zabbix.WithHostGetSelectInterfaces([]string{"interfaceid", "type", "main", "useip", "ip", "dns", "port", "available"}),
+			zabbix.WithHostGetSortField([]string{"host"}),
+			zabbix.WithHostGetAuth(z.Auth()),
+			zabbix.WithHostGetID(1),
+		}
+		if len(hostListGroups) > 0 {
+			groupIDs, err := resolveHostGroupIDs(ctx, z, hostListGroups)
+			if err != nil {
+				return err
+			}
+			options = append(options, zabbix.WithHostGetGroupIDs(groupIDs))
+		}
+		if len(hostListTemplates) > 0 {
+			templateIDs, err := resolveTemplateIDs(ctx, z, hostListTemplates)
+			if err != nil {
+				return err
+			}
+			options = append(options, zabbix.WithHostGetTemplateIDs(templateIDs))
+		}
+		if len(tags) > 0 {
+			options = append(options, zabbix.WithHostGetTags(hostTagFilters(tags)), zabbix.WithHostGetEvalType(evalType))
+		}
+		if status != "" {
+			options = append(options, zabbix.WithHostGetFilter(map[string]any{"status": status}))
+		}
+		if hostListName != "" {
+			options = append(options,
+				zabbix.WithHostGetSearch(map[string]any{"host": hostListName, "name": hostListName}),
+				zabbix.WithHostGetSearchByAny(true),
+				zabbix.WithHostGetSearchWildcardsEnabled(true))
+		}
+		// The availability is filtered on the client: the limit is then applied after filtering.
+		if hostListLimit > 0 && hostListAvailability == "" {
+			options = append(options, zabbix.WithHostGetLimit(hostListLimit))
+		}
+
+		response, err := z.HostGet(ctx, zabbix.NewHostGetRequest(options...))
+		if err != nil {
+			return fmt.Errorf("failed to get hosts: %w", err)
+		}
+		hosts := response.Result
+		if hostListAvailability != "" {
+			hosts = filterHostsByAvailability(hosts, hostListAvailability)
+			if hostListLimit > 0 && len(hosts) > hostListLimit {
+				hosts = hosts[:hostListLimit]
+			}
+		}
+
+		out := cmd.OutOrStdout()
+		if hostListOutput == "json" {
+			jsonOutput, err := json.MarshalIndent(hosts, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal hosts to JSON: %w", err)
+			}
+			fmt.Fprintln(out, string(jsonOutput))
+			return nil
+		}
+		printHostTable(out, hosts)
+		return nil
+	},
+}
+
+func init() {
+	HostListCmd.Flags().StringArrayVar(&hostListGroups, "group", nil, "Filter by host group, by name or glob; repeatable")
+	HostListCmd.Flags().StringArrayVar(&hostListTemplates, "template", nil, "Filter by linked template, by name or glob; repeatable")
+	HostListCmd.Flags().StringArrayVar(&hostListTags, "tag", nil, "Filter by tag (key=value, or key for any value); repeatable")
+	HostListCmd.Flags().StringVar(&hostListTagsEval, "tags-eval", "and", "How tags are combined: and, or")
+	HostListCmd.Flags().StringVar(&hostListStatus, "status", "", "Filter by status: enabled, disabled")
+	HostListCmd.Flags().StringVar(&hostListName, "name", "", "Filter by technical or visible name ('*' wildcard)")
+	HostListCmd.Flags().StringVar(&hostListAvailability, "availability", "", "Filter by availability: available, unavailable, unknown")
+	HostListCmd.Flags().IntVarP(&hostListLimit, "limit", "l", 0, "Limit the number of results")
+	HostListCmd.Flags().StringVarP(&hostListOutput, "output", "o", "table", "Output format: table or json")
+}
+
+// parseHostStatus parses a --status value ("enabled" or "disabled") into a host status.
+// An empty value returns an empty status.
+func parseHostStatus(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "":
+		return "", nil
+	case "enabled", "monitored":
+		return zabbix.HostStatusMonitored, nil
+	case "disabled", "unmonitored":
+		return zabbix.HostStatusUnmonitored, nil
+	default:
+		return "", fmt.Errorf("invalid status %q (expected 'enabled' or 'disabled')", value)
+	}
+}
+
+// parseHostTagsEvalType parses a --tags-eval value ("and" or "or") into a host.get evaltype.
+func parseHostTagsEvalType(value string) (int, error) {
+	evalType, err := parseTagsEvalType(value)
+	if err != nil {
+		return 0, err
+	}
+	if evalType == zabbix.TagsEvalTypeOr {
+		return hostTagsEvalOr, nil
+	}
+	return hostTagsEvalAndOr, nil
+}
+
+// hostTagFilters converts tags to host.get tag filters: tags with a value must be equal,
+// tags without value must exist.
+func hostTagFilters(tags []zabbix.ProblemTag) []zabbix.FilterProblemTags {
+	filters := make([]zabbix.FilterProblemTags, 0, len(tags))
+	for _, t := range tags {
+		operator := hostTagOperatorEquals
+		if t.Value == "" {
+			operator = hostTagOperatorExists
+		}
+		filters = append(filters, zabbix.FilterProblemTags{Tag: t.Tag, Value: t.Value, Operator: operator})
+	}
+	return filters
+}
+
+// hostAvailability returns the availability of a host from its interfaces: unavailable when one
+// of them is unavailable, available when one of them is available, unknown otherwise.
+func hostAvailability(h *zabbix.Host) string {
+	availability := hostUnknown
+	for _, i := range h.Interfaces {
+		switch i.Available {
+		case zabbix.HostInterfaceAvailabilityUnavailable:
+			return hostUnavailable
+		case zabbix.HostInterfaceAvailabilityAvailable:
+			availability = hostAvailable
+		}
+	}
+	return availability
+}
+
+// filterHostsByAvailability returns the hosts with the given availability.
+func filterHostsByAvailability(hosts []zabbix.Host, availability string) []zabbix.Host {
+	var selected []zabbix.Host
+	for i := range hosts {
+		if hostAvailability(&hosts[i]) == availability {
+			selected = append(selected, hosts[i])
+		}
+	}
+	return selected
+}
+
+// hostStatusName returns "enabled" or "disabled", with " (maintenance)" when a maintenance is in effect.
+func hostStatusName(h *zabbix.Host) string {
+	status := "enabled"
+	if !h.IsMonitored() {
+		status = "disabled"
+	}
+	if h.InMaintenance() {
+		status += " (maintenance)"
+	}
+	return status
+}
+
+// mainInterfaces returns the addresses of the main interfaces of a host, e.g. "agent 10.0.0.1:10050".
+func mainInterfaces(h *zabbix.Host) string {
+	var interfaces []string
+	for _, i := range h.Interfaces {
+		if i.IsMain() {
+			interfaces = append(interfaces, i.TypeName()+" "+i.Address())
+		}
+	}
+	return strings.Join(interfaces, ", ")
+}
+
+// printHostTable prints hosts with their status, availability, main interfaces and groups.
+func printHostTable(out io.Writer, hosts []zabbix.Host) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "HOSTID\tHOST\tNAME\tSTATUS\tAVAILABILITY\tINTERFACES\tGROUPS")
+	for i := range hosts {
+		h := &hosts[i]
+		groups := make([]string, 0, len(h.HostGroups))
+		for _, g := range h.HostGroups {
+			groups = append(groups, g.Name)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", h.HostID, h.Host, h.Name, hostStatusName(h),
+			hostAvailability(h), mainInterfaces(h), strings.Join(groups, ","))
+	}
+	w.Flush()
+	fmt.Fprintf(out, "Total hosts: %d\n", len(hosts))
+}
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var hostShowOutput string
+
+// HostShowCmd represents the host show command
+var HostShowCmd = &cobra.Command{
+	Use:   "show <name>",
+	Short: "Show the details of a host",
+	Long: `Show the details of a host given by technical or visible name: status, interfaces,
+host groups, linked templates, tags, macros and inventory. Secret macro values are never shown.
+
+Examples:
+  zabbix-cli host show web01
+  zabbix-cli host show web01 -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		host, err := getHostDetails(ctx, z, args[0])
+		if err != nil {
+			return err
+		}
+
+		out := cmd.OutOrStdout()
+		if hostShowOutput == "json" {
+			jsonOutput, err := json.MarshalIndent(host, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal host to JSON: %w", err)
+			}
+			fmt.Fprintln(out, string(jsonOutput))
+			return nil
+		}
+		printHostDetails(out, host)
+		return nil
+	},
+}
+
+func init() {
+	HostShowCmd.Flags().StringVarP(&hostShowOutput, "output", "o", "table", "Output format: table or json")
+}
+
+// getHostDetails returns the host with the given name, with its interfaces, host groups,
+// templates, tags, macros and inventory.
+func getHostDetails(ctx context.Context, z *zabbix.Client, name string) (*zabbix.Host, error) {
+	hosts, err := resolveHosts(ctx, z, []string{name})
+	if err != nil {
+		return nil, err
+	}
+	if len(hosts) > 1 {
+		return nil, fmt.Errorf("%q matches %d hosts, give a single host", name, len(hosts))
+	}
+	response, err := z.HostGet(ctx, zabbix.NewHostGetRequest(
+		zabbix.WithHostGetHostIDs([]string{hosts[0].HostID}),
+		zabbix.WithHostGetOutput("extend"),
+		zabbix.WithHostGetSelectHostGroups([]string{"groupid", "name"}),
+		zabbix.WithHostGetSelectInterfaces("extend"),
+		zabbix.WithHostGetSelectParentTemplates([]string{"templateid", "host", "name"}),
+		zabbix.WithHostGetSelectTags("extend"),
+		zabbix.WithHostGetSelectMacros("extend"),
+		zabbix.WithHostGetSelectInventory("extend"),
+		zabbix.WithHostGetAuth(z.Auth()),
+		zabbix.WithHostGetID(1),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host %q: %w", name, err)
+	}
+	if len(response.Result) == 0 {
+		return nil, fmt.Errorf("host %s: %w", name, errNotFound)
+	}
+	return &response.Result[0], nil
+}
+
+// inventoryModeName returns the name of a host inventory mode.
+func inventoryModeName(mode string) string {
+	switch mode {
+	case zabbix.HostInventoryModeDisabled:
+		return "disabled"
+	case zabbix.HostInventoryModeAutomatic:
+		return "automatic"
+	default:
+		return "manual"
+	}
+}
+
+// printHostDetails prints a host with its interfaces, host groups, templates, tags, macros and
+// non-empty inventory fields.
+func printHostDetails(out io.Writer, h *zabbix.Host) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Host:\t%s\n", h.Host)
+	fmt.Fprintf(w, "Name:\t%s\n", h.DisplayName())
+	fmt.Fprintf(w, "ID:\t%s\n", h.HostID)
+	fmt.Fprintf(w, "Status:\t%s\n", hostStatusName(h))
+	fmt.Fprintf(w, "Availability:\t%s\n", hostAvailability(h))
+	if h.Description != "" {
+		fmt.Fprintf(w, "Description:\t%s\n", h.Description)
+	}
+	groups := make([]string, 0, len(h.HostGroups))
+	for _, g := range h.HostGroups {
+		groups = append(groups, g.Name)
+	}
+	fmt.Fprintf(w, "Groups:\t%s\n", strings.Join(groups, ", "))
+	templates := make([]string, 0, len(h.ParentTemplates))
+	for _, t := range h.ParentTemplates {
+		templates = append(templates, t.Name)
+	}
+	fmt.Fprintf(w, "Templates:\t%s\n", strings.Join(templates, ", "))
+	tags := make([]string, 0, len(h.Tags))
+	for _, t := range h.Tags {
+		tags = append(tags, formatProblemTag(zabbix.ProblemTag{Tag: t.Tag, Value: t.Value}))
+	}
+	fmt.Fprintf(w, "Tags:\t%s\n", strings.Join(tags, ", "))
+	w.Flush()
+
+	if len(h.Interfaces) > 0 {
+		fmt.Fprintln(out, "\nInterfaces:")
+		w = tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "  TYPE\tADDRESS\tMAIN\tAVAILABILITY\tERROR")
+		for _, i := range h.Interfaces {
+			main := "no"
+			if i.IsMain() {
+				main = "yes"
+			}
+			availability := hostUnknown
+			switch i.Available {
+			case zabbix.HostInterfaceAvailabilityAvailable:
+				availability = hostAvailable
+			case zabbix.HostInterfaceAvailabilityUnavailable:
+				availability = hostUnavailable
+			}
+			line := fmt.Sprintf("  %s\t%s\t%s\t%s", i.TypeName(), i.Address(), main, availability)
+			if i.Error != "" {
+				line += "\t" + i.Error
+			}
+			fmt.Fprintln(w, line)
+		}
+		w.Flush()
+	}
+
+	if len(h.Macros) > 0 {
+		fmt.Fprintln(out, "\nMacros:")
+		w = tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+		for _, m := range h.Macros {
+			value := m.Value
+			if m.IsSecret() {
+				value = secretMask
+			}
+			line := fmt.Sprintf("  %s\t%s", m.Macro, value)
+			if m.Description != "" {
+				line += "\t" + m.Description
+			}
+			fmt.Fprintln(w, line)
+		}
+		w.Flush()
+	}
+
+	fields := make([]string, 0, len(h.Inventory))
+	for field, value := range h.Inventory {
+		if value != "" && field != "hostid" && field != "inventory_mode" {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) > 0 {
+		sort.Strings(fields)
+		fmt.Fprintf(out, "\nInventory (%s):\n", inventoryModeName(h.InventoryMode))
+		w = tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+		for _, field := range fields {
+			fmt.Fprintf(w, "  %s\t%s\n", field, h.Inventory[field])
+		}
+		w.Flush()
+	}
+}
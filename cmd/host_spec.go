@@ -0,0 +1,393 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultInterfacePorts are the default ports of the host interface types.
+var defaultInterfacePorts = map[string]string{
+	zabbix.HostInterfaceTypeAgent: "10050",
+	zabbix.HostInterfaceTypeSNMP:  "161",
+	zabbix.HostInterfaceTypeIPMI:  "623",
+	zabbix.HostInterfaceTypeJMX:   "12345",
+}
+
+// interfaceTypes are the host interface types by name.
+var interfaceTypes = map[string]string{
+	"agent": zabbix.HostInterfaceTypeAgent,
+	"snmp":  zabbix.HostInterfaceTypeSNMP,
+	"ipmi":  zabbix.HostInterfaceTypeIPMI,
+	"jmx":   zabbix.HostInterfaceTypeJMX,
+}
+
+// snmpSecurityLevels, snmpAuthProtocols and snmpPrivProtocols are the SNMPv3 settings by name.
+var (
+	snmpSecurityLevels = map[string]string{"noauthnopriv": "0", "authnopriv": "1", "authpriv": "2"}
+	snmpAuthProtocols  = map[string]string{"md5": "0", "sha1": "1", "sha224": "2", "sha256": "3", "sha384": "4", "sha512": "5"}
+	snmpPrivProtocols  = map[string]string{"des": "0", "aes128": "1", "aes192": "2", "aes256": "3", "aes192c": "4", "aes256c": "5"}
+)
+
+// hostSpec is the description of a host read from a YAML file by 'host create' and 'host update'.
+// Host groups and templates are referenced by name.
+type hostSpec struct {
+	Host          string          `yaml:"host"`
+	Name          string          `yaml:"name,omitempty"`
+	Description   string          `yaml:"description,omitempty"`
+	Status        string          `yaml:"status,omitempty"`         // enabled or disabled
+	InventoryMode string          `yaml:"inventory_mode,omitempty"` // disabled, manual or automatic
+	Groups        []string        `yaml:"groups,omitempty"`
+	Templates     []string        `yaml:"templates,omitempty"`
+	Tags          []hostTagSpec   `yaml:"tags,omitempty"`
+	Interfaces    []interfaceSpec `yaml:"interfaces,omitempty"`
+	Macros        []macroSpec     `yaml:"macros,omitempty"`
+}
+
+// hostTagSpec is a tag of a host spec.
+type hostTagSpec struct {
+	Tag   string `yaml:"tag"`
+	Value string `yaml:"value,omitempty"`
+}
+
+// macroSpec is a user macro of a host spec.
+type macroSpec struct {
+	Macro       string `yaml:"macro"`
+	Value       string `yaml:"value"`
+	Description string `yaml:"description,omitempty"`
+	Secret      bool   `yaml:"secret,omitempty"`
+}
+
+// interfaceSpec is an interface of a host spec. The first interface of each type is the main one.
+type interfaceSpec struct {
+	Type    string    `yaml:"type"`           // agent, snmp, ipmi or jmx
+	Address string    `yaml:"address"`        // IP address or DNS name
+	Port    string    `yaml:"port,omitempty"` // default: the default port of the type
+	SNMP    *snmpSpec `yaml:"snmp,omitempty"` // required for snmp interfaces
+}
+
+// snmpSpec holds the SNMP details of an interface spec.
+type snmpSpec struct {
+	Version        string `yaml:"version"` // 1, 2c or 3
+	Community      string `yaml:"community,omitempty"`
+	Bulk           *bool  `yaml:"bulk,omitempty"` // default: true
+	SecurityName   string `yaml:"security_name,omitempty"`
+	SecurityLevel  string `yaml:"security_level,omitempty"` // noAuthNoPriv, authNoPriv or authPriv
+	AuthProtocol   string `yaml:"auth_protocol,omitempty"`  // md5, sha1, sha224, sha256, sha384 or sha512
+	AuthPassphrase string `yaml:"auth_passphrase,omitempty"`
+	PrivProtocol   string `yaml:"priv_protocol,omitempty"` // des, aes128, aes192, aes256, aes192c or aes256c
+	PrivPassphrase string `yaml:"priv_passphrase,omitempty"`
+	ContextName    string `yaml:"context_name,omitempty"`
+}
+
+// hostFlags are the flags of 'host create' and 'host update' describing a host.
+type hostFlags struct {
+	specFile      string
+	name          string
+	description   string
+	status        string
+	inventoryMode string
+	groups        []string
+	templates     []string
+	tags          []string
+	agent         []string
+	snmp          []string
+	ipmi          []string
+	jmx           []string
+	snmpDetails   snmpSpec
+	snmpBulk      bool
+}
+
+// addFlags adds the host flags to a command.
+func (f *hostFlags) addFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(&f.specFile, "spec", "f", "", "YAML file describing the host (\"-\" for stdin); flags override it")
+	flags.StringVar(&f.name, "name", "", "Visible name")
+	flags.StringVar(&f.description, "description", "", "Description")
+	flags.StringVar(&f.status, "status", "", "Status: enabled, disabled")
+	flags.StringVar(&f.inventoryMode, "inventory-mode", "", "Inventory mode: disabled, manual, automatic")
+	flags.StringArrayVar(&f.groups, "group", nil, "Host group, by name or glob; repeatable")
+	flags.StringArrayVar(&f.templates, "template", nil, "Template to link, by name or glob; repeatable")
+	flags.StringArrayVar(&f.tags, "tag", nil, "Tag (key=value); repeatable")
+	flags.StringArrayVar(&f.agent, "agent", nil, "Agent interface, address[:port]; repeatable")
+	flags.StringArrayVar(&f.snmp, "snmp", nil, "SNMP interface, address[:port]; repeatable")
+	flags.StringArrayVar(&f.ipmi, "ipmi", nil, "IPMI interface, address[:port]; repeatable")
+	flags.StringArrayVar(&f.jmx, "jmx", nil, "JMX interface, address[:port]; repeatable")
+	flags.StringVar(&f.snmpDetails.Version, "snmp-version", "2c", "SNMP version of the --snmp interfaces: 1, 2c, 3")
+	flags.StringVar(&f.snmpDetails.Community, "snmp-community", "{$SNMP_COMMUNITY}", "SNMP community (v1, v2c)")
+	flags.BoolVar(&f.snmpBulk, "snmp-bulk", true, "Use SNMP bulk requests")
+	flags.StringVar(&f.snmpDetails.SecurityName, "snmp-security-name", "", "SNMPv3 security name")
+	flags.StringVar(&f.snmpDetails.SecurityLevel, "snmp-security-level", "noAuthNoPriv", "SNMPv3 security level: noAuthNoPriv, authNoPriv, authPriv")
+	flags.StringVar(&f.snmpDetails.AuthProtocol, "snmp-auth-protocol", "", "SNMPv3 authentication protocol: md5, sha1, sha224, sha256, sha384, sha512")
+	flags.StringVar(&f.snmpDetails.AuthPassphrase, "snmp-auth-passphrase", "", "SNMPv3 authentication passphrase")
+	flags.StringVar(&f.snmpDetails.PrivProtocol, "snmp-priv-protocol", "", "SNMPv3 privacy protocol: des, aes128, aes192, aes256, aes192c, aes256c")
+	flags.StringVar(&f.snmpDetails.PrivPassphrase, "snmp-priv-passphrase", "", "SNMPv3 privacy passphrase")
+	flags.StringVar(&f.snmpDetails.ContextName, "snmp-context", "", "SNMPv3 context name")
+}
+
+// spec returns the host spec read from --spec, overridden by the flags that were set.
+func (f *hostFlags) spec(cmd *cobra.Command) (*hostSpec, error) {
+	spec := &hostSpec{}
+	if f.specFile != "" {
+		var err error
+		if spec, err = readHostSpec(f.specFile, cmd.InOrStdin()); err != nil {
+			return nil, err
+		}
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("name") {
+		spec.Name = f.name
+	}
+	if flags.Changed("description") {
+		spec.Description = f.description
+	}
+	if flags.Changed("status") {
+		spec.Status = f.status
+	}
+	if flags.Changed("inventory-mode") {
+		spec.InventoryMode = f.inventoryMode
+	}
+	if flags.Changed("group") {
+		spec.Groups = f.groups
+	}
+	if flags.Changed("template") {
+		spec.Templates = f.templates
+	}
+	if flags.Changed("tag") {
+		tags, err := parseTags(f.tags)
+		if err != nil {
+			return nil, err
+		}
+		spec.Tags = make([]hostTagSpec, 0, len(tags))
+		for _, t := range tags {
+			spec.Tags = append(spec.Tags, hostTagSpec{Tag: t.Tag, Value: t.Value})
+		}
+	}
+
+	snmpChanged := false
+	flags.Visit(func(flag *pflag.Flag) {
+		if strings.HasPrefix(flag.Name, "snmp-") {
+			snmpChanged = true
+		}
+	})
+	if snmpChanged && len(f.snmp) == 0 {
+		return nil, fmt.Errorf("--snmp-* flags require at least one --snmp interface")
+	}
+	if len(f.agent)+len(f.snmp)+len(f.ipmi)+len(f.jmx) == 0 {
+		return spec, nil
+	}
+	spec.Interfaces = nil
+	for _, i := range []struct {
+		typ       string
+		addresses []string
+	}{{"agent", f.agent}, {"snmp", f.snmp}, {"ipmi", f.ipmi}, {"jmx", f.jmx}} {
+		for _, address := range i.addresses {
+			host, port := splitAddressPort(address)
+			is := interfaceSpec{Type: i.typ, Address: host, Port: port}
+			if i.typ == "snmp" {
+				details := f.snmpDetails
+				details.Bulk = &f.snmpBulk
+				is.SNMP = &details
+			}
+			spec.Interfaces = append(spec.Interfaces, is)
+		}
+	}
+	return spec, nil
+}
+
+// readHostSpec reads a host spec from a YAML file ("-" for stdin).
+func readHostSpec(path string, stdin io.Reader) (*hostSpec, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", path, err)
+	}
+
+	var spec hostSpec
+	decoder := yaml.NewDecoder(strings.NewReader(string(data)))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&spec); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("cannot parse %s: %w", path, err)
+	}
+	return &spec, nil
+}
+
+// splitAddressPort splits "address[:port]" into an address and a port, which is empty when not
+// given. IPv6 addresses must be enclosed in brackets to be given a port.
+func splitAddressPort(value string) (string, string) {
+	if host, port, err := net.SplitHostPort(value); err == nil {
+		return host, port
+	}
+	return strings.Trim(value, "[]"), ""
+}
+
+// toInterfaces converts interface specs to host interfaces. The first interface of each type
+// is the main one.
+func toInterfaces(specs []interfaceSpec) ([]zabbix.HostInterface, error) {
+	interfaces := make([]zabbix.HostInterface, 0, len(specs))
+	seen := make(map[string]bool)
+	for i, s := range specs {
+		typ, ok := interfaceTypes[strings.ToLower(s.Type)]
+		if !ok {
+			return nil, fmt.Errorf("interface #%d: invalid type %q (expected agent, snmp, ipmi or jmx)", i+1, s.Type)
+		}
+		if s.Address == "" {
+			return nil, fmt.Errorf("interface #%d: no address", i+1)
+		}
+		hi := zabbix.HostInterface{
+			Type:  typ,
+			Main:  "1",
+			UseIP: "0",
+			DNS:   s.Address,
+			Port:  defaultString(s.Port, defaultInterfacePorts[typ]),
+		}
+		if seen[typ] {
+			hi.Main = "0"
+		}
+		seen[typ] = true
+		if net.ParseIP(s.Address) != nil {
+			hi.UseIP, hi.IP, hi.DNS = "1", s.Address, ""
+		}
+		if typ == zabbix.HostInterfaceTypeSNMP {
+			if s.SNMP == nil {
+				return nil, fmt.Errorf("interface #%d: snmp details are required for snmp interfaces", i+1)
+			}
+			details, err := s.SNMP.toDetails()
+			if err != nil {
+				return nil, fmt.Errorf("interface #%d: %w", i+1, err)
+			}
+			hi.Details = details
+		}
+		interfaces = append(interfaces, hi)
+	}
+	return interfaces, nil
+}
+
+// toDetails converts SNMP settings to the details of an SNMP interface.
+func (s *snmpSpec) toDetails() (*zabbix.HostInterfaceDetails, error) {
+	d := &zabbix.HostInterfaceDetails{Bulk: "1"}
+	if s.Bulk != nil && !*s.Bulk {
+		d.Bulk = "0"
+	}
+	switch strings.ToLower(s.Version) {
+	case "1":
+		d.Version, d.Community = zabbix.SNMPVersion1, s.Community
+	case "2", "2c", "":
+		d.Version, d.Community = zabbix.SNMPVersion2c, s.Community
+	case "3":
+		d.Version = zabbix.SNMPVersion3
+		d.SecurityName = s.SecurityName
+		d.ContextName = s.ContextName
+		var ok bool
+		if d.SecurityLevel, ok = snmpSecurityLevels[strings.ToLower(defaultString(s.SecurityLevel, "noAuthNoPriv"))]; !ok {
+			return nil, fmt.Errorf("invalid SNMP security level %q (expected noAuthNoPriv, authNoPriv or authPriv)", s.SecurityLevel)
+		}
+		if d.SecurityLevel != "0" {
+			if d.AuthProtocol, ok = snmpAuthProtocols[strings.ToLower(defaultString(s.AuthProtocol, "sha1"))]; !ok {
+				return nil, fmt.Errorf("invalid SNMP authentication protocol %q", s.AuthProtocol)
+			}
+			d.AuthPassphrase = s.AuthPassphrase
+		}
+		if d.SecurityLevel == "2" {
+			if d.PrivProtocol, ok = snmpPrivProtocols[strings.ToLower(defaultString(s.PrivProtocol, "aes128"))]; !ok {
+				return nil, fmt.Errorf("invalid SNMP privacy protocol %q", s.PrivProtocol)
+			}
+			d.PrivPassphrase = s.PrivPassphrase
+		}
+	default:
+		return nil, fmt.Errorf("invalid SNMP version %q (expected 1, 2c or 3)", s.Version)
+	}
+	return d, nil
+}
+
+// parseInventoryMode parses an inventory mode name (disabled, manual or automatic).
+// An empty value returns an empty mode.
+func parseInventoryMode(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "":
+		return "", nil
+	case "disabled":
+		return zabbix.HostInventoryModeDisabled, nil
+	case "manual":
+		return zabbix.HostInventoryModeManual, nil
+	case "automatic":
+		return zabbix.HostInventoryModeAutomatic, nil
+	default:
+		return "", fmt.Errorf("invalid inventory mode %q (expected disabled, manual or automatic)", value)
+	}
+}
+
+// toHostParams converts a spec to host.create or host.update parameters, resolving host group and
+// template names to IDs. Only the properties set in the spec are set.
+func (s *hostSpec) toHostParams(ctx context.Context, z *zabbix.Client) (zabbix.HostParams, error) {
+	params, err := s.hostParams()
+	if err != nil {
+		return params, err
+	}
+	if len(s.Groups) > 0 {
+		groupIDs, err := resolveHostGroupIDs(ctx, z, s.Groups)
+		if err != nil {
+			return params, err
+		}
+		for _, id := range groupIDs {
+			params.Groups = append(params.Groups, zabbix.HostGroupID{GroupID: id})
+		}
+	}
+	if len(s.Templates) > 0 {
+		templateIDs, err := resolveTemplateIDs(ctx, z, s.Templates)
+		if err != nil {
+			return params, err
+		}
+		for _, id := range templateIDs {
+			params.Templates = append(params.Templates, zabbix.TemplateID{TemplateID: id})
+		}
+	}
+	return params, nil
+}
+
+// hostParams converts a spec to host parameters, except for the host groups and
+// templates which must be resolved.
+func (s *hostSpec) hostParams() (zabbix.HostParams, error) {
+	params := zabbix.HostParams{
+		Host:        s.Host,
+		Name:        s.Name,
+		Description: s.Description,
+	}
+	var err error
+	if params.Status, err = parseHostStatus(s.Status); err != nil {
+		return params, err
+	}
+	if params.InventoryMode, err = parseInventoryMode(s.InventoryMode); err != nil {
+		return params, err
+	}
+	for _, t := range s.Tags {
+		params.Tags = append(params.Tags, zabbix.HostTag{Tag: t.Tag, Value: t.Value})
+	}
+	if params.Interfaces, err = toInterfaces(s.Interfaces); err != nil {
+		return params, err
+	}
+	if len(params.Interfaces) == 0 {
+		params.Interfaces = nil
+	}
+	for _, m := range s.Macros {
+		macro := zabbix.UserMacro{Macro: m.Macro, Value: m.Value, Description: m.Description}
+		if m.Secret {
+			macro.Type = zabbix.UserMacroTypeSecret
+		}
+		params.Macros = append(params.Macros, macro)
+	}
+	return params, nil
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitAddressPort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value, address, port string
+	}{
+		{"10.0.0.1", "10.0.0.1", ""},
+		{"10.0.0.1:10051", "10.0.0.1", "10051"},
+		{"web01.example.com:{$AGENT_PORT}", "web01.example.com", "{$AGENT_PORT}"},
+		{"2001:db8::1", "2001:db8::1", ""},
+		{"[2001:db8::1]:161", "2001:db8::1", "161"},
+	}
+	for _, tt := range tests {
+		address, port := splitAddressPort(tt.value)
+		assert.Equal(t, tt.address, address, tt.value)
+		assert.Equal(t, tt.port, port, tt.value)
+	}
+}
+
+func TestToInterfaces(t *testing.T) {
+	t.Parallel()
+
+	interfaces, err := toInterfaces([]interfaceSpec{
+		{Type: "agent", Address: "10.0.0.1"},
+		{Type: "agent", Address: "web01.example.com", Port: "10051"},
+		{Type: "snmp", Address: "10.0.0.1", SNMP: &snmpSpec{Version: "3", SecurityName: "zabbix", SecurityLevel: "authPriv",
+			AuthProtocol: "sha256", AuthPassphrase: "a", PrivPassphrase: "p"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []zabbix.HostInterface{
+		{Type: zabbix.HostInterfaceTypeAgent, Main: "1", UseIP: "1", IP: "10.0.0.1", Port: "10050"},
+		{Type: zabbix.HostInterfaceTypeAgent, Main: "0", UseIP: "0", DNS: "web01.example.com", Port: "10051"},
+		{Type: zabbix.HostInterfaceTypeSNMP, Main: "1", UseIP: "1", IP: "10.0.0.1", Port: "161",
+			Details: &zabbix.HostInterfaceDetails{Version: zabbix.SNMPVersion3, Bulk: "1", SecurityName: "zabbix", SecurityLevel: "2",
+				AuthProtocol: "3", AuthPassphrase: "a", PrivProtocol: "1", PrivPassphrase: "p"}},
+	}, interfaces)
+
+	_, err = toInterfaces([]interfaceSpec{{Type: "snmp", Address: "10.0.0.1"}})
+	assert.ErrorContains(t, err, "snmp details are required")
+	_, err = toInterfaces([]interfaceSpec{{Type: "ssh", Address: "10.0.0.1"}})
+	assert.ErrorContains(t, err, "invalid type")
+	_, err = toInterfaces([]interfaceSpec{{Type: "snmp", Address: "10.0.0.1", SNMP: &snmpSpec{Version: "4"}}})
+	assert.ErrorContains(t, err, "invalid SNMP version")
+}
+
+func TestReadHostSpec(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "sw01.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`host: sw01
+status: disabled
+inventory_mode: automatic
+groups: [Network]
+tags:
+  - {tag: site, value: par1}
+interfaces:
+  - type: snmp
+    address: sw01.example.com
+    snmp: {version: 2c, community: public, bulk: false}
+macros:
+  - {macro: "{$SNMP_COMMUNITY}", value: public, secret: true}
+`), 0o600))
+
+	spec, err := readHostSpec(path, nil)
+	require.NoError(t, err)
+	params, err := spec.hostParams()
+	require.NoError(t, err)
+	assert.Equal(t, zabbix.HostParams{
+		Host:          "sw01",
+		Status:        zabbix.HostStatusUnmonitored,
+		InventoryMode: zabbix.HostInventoryModeAutomatic,
+		Tags:          []zabbix.HostTag{{Tag: "site", Value: "par1"}},
+		Interfaces: []zabbix.HostInterface{{Type: zabbix.HostInterfaceTypeSNMP, Main: "1", UseIP: "0", DNS: "sw01.example.com", Port: "161",
+			Details: &zabbix.HostInterfaceDetails{Version: zabbix.SNMPVersion2c, Bulk: "0", Community: "public"}}},
+		Macros: []zabbix.UserMacro{{Macro: "{$SNMP_COMMUNITY}", Value: "public", Type: zabbix.UserMacroTypeSecret}},
+	}, params)
+	assert.Equal(t, []string{"Network"}, spec.Groups)
+
+	require.NoError(t, os.WriteFile(path, []byte("host: sw01\nunknown: 1\n"), 0o600))
+	_, err = readHostSpec(path, nil)
+	assert.Error(t, err)
+}
+
+func TestKeepInterfaceIDs(t *testing.T) {
+	t.Parallel()
+
+	interfaces := []zabbix.HostInterface{
+		{Type: zabbix.HostInterfaceTypeAgent}, {Type: zabbix.HostInterfaceTypeAgent}, {Type: zabbix.HostInterfaceTypeSNMP},
+	}
+	keepInterfaceIDs(interfaces, []zabbix.HostInterface{
+		{InterfaceID: "1", Type: zabbix.HostInterfaceTypeAgent}, {InterfaceID: "2", Type: zabbix.HostInterfaceTypeJMX},
+	})
+	assert.Equal(t, "1", interfaces[0].InterfaceID)
+	assert.Empty(t, interfaces[1].InterfaceID)
+	assert.Empty(t, interfaces[2].InterfaceID)
+}
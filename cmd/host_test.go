@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHostStatus(t *testing.T) {
+	t.Parallel()
+
+	status, err := parseHostStatus("Enabled")
+	require.NoError(t, err)
+	assert.Equal(t, zabbix.HostStatusMonitored, status)
+	status, err = parseHostStatus("disabled")
+	require.NoError(t, err)
+	assert.Equal(t, zabbix.HostStatusUnmonitored, status)
+	status, err = parseHostStatus("")
+	require.NoError(t, err)
+	assert.Empty(t, status)
+	_, err = parseHostStatus("paused")
+	assert.Error(t, err)
+}
+
+func TestHostTagFilters(t *testing.T) {
+	t.Parallel()
+
+	filters := hostTagFilters([]zabbix.ProblemTag{{Tag: "env", Value: "prod"}, {Tag: "team"}})
+	assert.Equal(t, []zabbix.FilterProblemTags{
+		{Tag: "env", Value: "prod", Operator: hostTagOperatorEquals},
+		{Tag: "team", Operator: hostTagOperatorExists},
+	}, filters)
+}
+
+func TestParseHostTagsEvalType(t *testing.T) {
+	t.Parallel()
+
+	evalType, err := parseHostTagsEvalType("or")
+	require.NoError(t, err)
+	assert.Equal(t, 2, evalType)
+
+	evalType, err = parseHostTagsEvalType("and")
+	require.NoError(t, err)
+	assert.Equal(t, 0, evalType)
+
+	_, err = parseHostTagsEvalType("xor")
+	require.Error(t, err)
+}
+
+func TestHostAvailability(t *testing.T) {
+	t.Parallel()
+
+	iface := func(available string) zabbix.HostInterface {
+		return zabbix.HostInterface{Type: zabbix.HostInterfaceTypeAgent, Available: available}
+	}
+	hosts := []zabbix.Host{
+		{Host: "up", Interfaces: []zabbix.HostInterface{iface("1"), iface("0")}},
+		{Host: "down", Interfaces: []zabbix.HostInterface{iface("1"), iface("2")}},
+		{Host: "new", Interfaces: []zabbix.HostInterface{iface("0")}},
+		{Host: "none"},
+	}
+	assert.Equal(t, hostAvailable, hostAvailability(&hosts[0]))
+	assert.Equal(t, hostUnavailable, hostAvailability(&hosts[1]))
+	assert.Equal(t, hostUnknown, hostAvailability(&hosts[2]))
+	assert.Equal(t, hostUnknown, hostAvailability(&hosts[3]))
+
+	selected := filterHostsByAvailability(hosts, hostUnknown)
+	require.Len(t, selected, 2)
+	assert.Equal(t, "new", selected[0].Host)
+	assert.Equal(t, "none", selected[1].Host)
+}
+
+func TestPlanHostStatus(t *testing.T) {
+	t.Parallel()
+
+	hosts := []zabbix.Host{
+		{HostID: "1", Status: zabbix.HostStatusMonitored},
+		{HostID: "2", Status: zabbix.HostStatusUnmonitored},
+	}
+	changed, skipped := planHostStatus(hosts, zabbix.HostStatusUnmonitored)
+	assert.Equal(t, []zabbix.Host{hosts[0]}, changed)
+	assert.Equal(t, []zabbix.Host{hosts[1]}, skipped)
+
+	changed, skipped = planHostStatus(hosts, zabbix.HostStatusMonitored)
+	assert.Equal(t, []zabbix.Host{hosts[1]}, changed)
+	assert.Equal(t, []zabbix.Host{hosts[0]}, skipped)
+}
+
+func TestPrintHostDetails(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	printHostDetails(&out, &zabbix.Host{
+		HostID:            "10084",
+		Host:              "web01",
+		Status:            zabbix.HostStatusMonitored,
+		MaintenanceStatus: "1",
+		InventoryMode:     zabbix.HostInventoryModeAutomatic,
+		HostGroups:        []zabbix.HostGroup{{Name: "Linux servers"}, {Name: "Web"}},
+		ParentTemplates:   []zabbix.Template{{Name: "Linux by Zabbix agent"}},
+		Tags:              []zabbix.HostTag{{Tag: "env", Value: "prod"}},
+		Interfaces: []zabbix.HostInterface{
+			{Type: zabbix.HostInterfaceTypeAgent, Main: "1", UseIP: "1", IP: "10.0.0.1", Port: "10050", Available: "1"},
+		},
+		Macros:    []zabbix.UserMacro{{Macro: "{$PASS}", Type: zabbix.UserMacroTypeSecret}, {Macro: "{$PORT}", Value: "8080"}},
+		Inventory: zabbix.HostInventory{"os": "Debian 12", "serialno_a": "", "hostid": "10084"},
+	})
+	assert.Equal(t, `Host:          web01
+Name:          web01
+ID:            10084
+Status:        enabled (maintenance)
+Availability:  available
+Groups:        Linux servers, Web
+Templates:     Linux by Zabbix agent
+Tags:          env=prod
+
+Interfaces:
+  TYPE    ADDRESS          MAIN   AVAILABILITY   ERROR
+  agent   10.0.0.1:10050   yes    available
+
+Macros:
+  {$PASS}   ******
+  {$PORT}   8080
+
+Inventory (automatic):
+  os   Debian 12
+`, out.String())
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	hostUpdateFlags  hostFlags
+	hostUpdateRename string
+)
+
+// HostUpdateCmd represents the host update command
+var HostUpdateCmd = &cobra.Command{
+	Use:   "update <name>",
+	Short: "Update a host",
+	Long: `Update a host given by technical or visible name.
+
+The flags and the YAML spec are the same as for create. Only the properties that are given are
+changed, and they replace the current ones: --group replaces the host groups, --template the
+linked templates (the other templates are unlinked, keeping their entities), --tag the tags and
+the interface flags the interfaces. Interfaces keep their ID when one of the same type exists,
+so that the items using them are kept.
+
+Examples:
+  zabbix-cli host update web01 --name "Web server 01" --tag env=prod --tag team=web
+  zabbix-cli host update web01 --rename web01.example.com
+  zabbix-cli host update sw01 --snmp 10.0.0.2 --snmp-version 3 --snmp-security-name zabbix`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		spec, err := hostUpdateFlags.spec(cmd)
+		if err != nil {
+			return err
+		}
+		spec.Host = hostUpdateRename
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		host, err := getHostDetails(ctx, z, args[0])
+		if err != nil {
+			return err
+		}
+		params, err := spec.toHostParams(ctx, z)
+		if err != nil {
+			return err
+		}
+		params.HostID = host.HostID
+		keepInterfaceIDs(params.Interfaces, host.Interfaces)
+		if params.Host == "" && params.Name == "" && params.Description == "" && params.Status == "" &&
+			params.InventoryMode == "" && params.Groups == nil && params.Templates == nil && params.Tags == nil &&
+			params.Interfaces == nil && params.Macros == nil {
+			return fmt.Errorf("nothing to update")
+		}
+
+		_, err = z.HostUpdate(ctx, zabbix.NewHostUpdateRequest(params,
+			zabbix.WithHostUpdateAuth(z.Auth()),
+			zabbix.WithHostUpdateID(1),
+		))
+		if err != nil {
+			return fmt.Errorf("failed to update host %q: %w", host.Host, err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Successfully updated host %q\n", host.Host)
+		return nil
+	},
+}
+
+func init() {
+	hostUpdateFlags.addFlags(HostUpdateCmd.Flags())
+	HostUpdateCmd.Flags().StringVar(&hostUpdateRename, "rename", "", "New technical name")
+}
+
+// keepInterfaceIDs sets the ID of the current interfaces on the new interfaces of the same type,
+// in order, so that host.update updates them instead of replacing them.
+func keepInterfaceIDs(interfaces, current []zabbix.HostInterface) {
+	used := make(map[string]bool)
+	for i := range interfaces {
+		for _, c := range current {
+			if c.Type == interfaces[i].Type && !used[c.InterfaceID] {
+				interfaces[i].InterfaceID = c.InterfaceID
+				used[c.InterfaceID] = true
+				break
+			}
+		}
+	}
+}
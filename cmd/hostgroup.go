@@ -6,11 +6,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// HostgroupCmd represents the get subcommand (get problems, get hosts ...)
+// HostgroupCmd represents the hostgroup command (get, create, rename, delete ...)
 var HostgroupCmd = &cobra.Command{
 	Use:   "hostgroup",
-	Short: "get hostgroups",
-	Long:  `get hostgroups`,
+	Short: "Manage host groups",
+	Long:  `Get, create, rename and delete host groups, and manage their hosts`,
 	Run: func(cmd *cobra.Command, _ []string) {
 		// print help
 		cmd.Help() //nolint:errcheck
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+// HostGroupCreateCmd represents the hostgroup create command
+var HostGroupCreateCmd = &cobra.Command{
+	Use:   "create <name...>",
+	Short: "Create host groups",
+	Long: `Create one or more host groups. Use "/" in names to nest groups, e.g. "Prod/EU/Web".
+
+Examples:
+  zabbix-cli hostgroup create "Prod/EU/Web" "Prod/EU/DB"`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		response, err := z.HostGroupCreate(ctx, zabbix.NewHostGroupCreateRequest(args,
+			zabbix.WithHostGroupCreateAuth(z.Auth()),
+			zabbix.WithHostGroupCreateID(1),
+		))
+		if err != nil {
+			return fmt.Errorf("failed to create host groups: %w", err)
+		}
+
+		out := cmd.OutOrStdout()
+		for i, id := range response.Result.GroupIDs {
+			fmt.Fprintf(out, "Created host group %q (ID: %s)\n", args[i], id)
+		}
+		return nil
+	},
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	hostGroupDeleteForce  bool
+	hostGroupDeleteDryRun bool
+	hostGroupDeleteYes    bool
+)
+
+// HostGroupDeleteCmd represents the hostgroup delete command
+var HostGroupDeleteCmd = &cobra.Command{
+	Use:   "delete <name|id...>",
+	Short: "Delete host groups",
+	Long: `Delete host groups given by name or ID.
+
+Internal host groups cannot be deleted. Host groups that still contain hosts are refused unless
+--force is given; Zabbix still refuses to delete a group that is the only group of a host.
+The host groups are listed and a confirmation is asked unless --yes is given.
+Use --dry-run to only list them.
+
+Examples:
+  zabbix-cli hostgroup delete "Prod/EU/Old" 42
+  zabbix-cli hostgroup delete "Staging" --force --dry-run`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		groups, err := getHostGroupsByIDOrName(ctx, z, args)
+		if err != nil {
+			return err
+		}
+
+		out := cmd.OutOrStdout()
+		printHostGroupPreview(out, groups)
+		if err := checkHostGroupsDeletable(groups, hostGroupDeleteForce); err != nil {
+			return err
+		}
+		if hostGroupDeleteDryRun {
+			fmt.Fprintf(out, "Dry run: %d host group(s) would be deleted\n", len(groups))
+			return nil
+		}
+		if !hostGroupDeleteYes && !confirm(cmd.InOrStdin(), out, fmt.Sprintf("Delete %d host group(s)?", len(groups))) {
+			return fmt.Errorf("aborted by user")
+		}
+
+		ids := make([]string, 0, len(groups))
+		for _, g := range groups {
+			ids = append(ids, g.GroupID)
+		}
+		response, err := z.HostGroupDelete(ctx, zabbix.NewHostGroupDeleteRequest(ids,
+			zabbix.WithHostGroupDeleteAuth(z.Auth()),
+			zabbix.WithHostGroupDeleteID(1),
+		))
+		if err != nil {
+			return fmt.Errorf("failed to delete host groups: %w", err)
+		}
+
+		fmt.Fprintf(out, "Successfully deleted %d host group(s)\n", len(response.Result.GroupIDs))
+		return nil
+	},
+}
+
+func init() {
+	HostGroupDeleteCmd.Flags().BoolVar(&hostGroupDeleteForce, "force", false, "Delete host groups that still contain hosts")
+	HostGroupDeleteCmd.Flags().BoolVar(&hostGroupDeleteDryRun, "dry-run", false, "List the host groups that would be deleted without deleting them")
+	HostGroupDeleteCmd.Flags().BoolVarP(&hostGroupDeleteYes, "yes", "y", false, "Do not ask for confirmation")
+}
+
+// checkHostGroupsDeletable returns an error listing the internal host groups, and the host groups
+// that still contain hosts unless force is true.
+func checkHostGroupsDeletable(groups []zabbix.HostGroup, force bool) error {
+	var internal, notEmpty []string
+	for _, g := range groups {
+		switch {
+		case g.Internal == "1":
+			internal = append(internal, fmt.Sprintf("%q", g.Name))
+		case len(g.Hosts) > 0 && !force:
+			notEmpty = append(notEmpty, fmt.Sprintf("%q (%d hosts)", g.Name, len(g.Hosts)))
+		}
+	}
+
+	var errs []error
+	if len(internal) > 0 {
+		errs = append(errs, fmt.Errorf("internal host group(s) cannot be deleted: %s", strings.Join(internal, ", ")))
+	}
+	if len(notEmpty) > 0 {
+		errs = append(errs, fmt.Errorf("host group(s) still contain hosts, use --force to delete them: %s", strings.Join(notEmpty, ", ")))
+	}
+	return errors.Join(errs...)
+}
+
+// printHostGroupPreview prints the host groups about to be deleted.
+func printHostGroupPreview(out io.Writer, groups []zabbix.HostGroup) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tHOSTS\tINTERNAL")
+	for _, g := range groups {
+		internal := "no"
+		if g.Internal == "1" {
+			internal = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", g.GroupID, g.Name, len(g.Hosts), internal)
+	}
+	w.Flush()
+}
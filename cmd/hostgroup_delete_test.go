@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectHostGroups(t *testing.T) {
+	t.Parallel()
+
+	groups := []zabbix.HostGroup{
+		{GroupID: "2", Name: "Linux servers"},
+		{GroupID: "5", Name: "Discovered hosts"},
+		{GroupID: "42", Name: "2"},
+	}
+	ids := func(selected []zabbix.HostGroup) []string {
+		var result []string
+		for _, g := range selected {
+			result = append(result, g.GroupID)
+		}
+		return result
+	}
+
+	selected, missing := selectHostGroups(groups, []string{"Linux servers", "5", "nope", "2", "2"})
+	assert.Equal(t, []string{"2", "5", "42"}, ids(selected), "names take precedence over IDs")
+	assert.Equal(t, []string{"nope"}, missing)
+}
+
+func TestCheckHostGroupsDeletable(t *testing.T) {
+	t.Parallel()
+
+	empty := zabbix.HostGroup{GroupID: "10", Name: "Old"}
+	used := zabbix.HostGroup{GroupID: "11", Name: "Web", Hosts: []zabbix.Host{{HostID: "1"}, {HostID: "2"}}}
+	internal := zabbix.HostGroup{GroupID: "5", Name: "Discovered hosts", Internal: "1"}
+
+	assert.NoError(t, checkHostGroupsDeletable([]zabbix.HostGroup{empty}, false))
+	assert.NoError(t, checkHostGroupsDeletable([]zabbix.HostGroup{empty, used}, true))
+
+	err := checkHostGroupsDeletable([]zabbix.HostGroup{empty, used}, false)
+	assert.EqualError(t, err, `host group(s) still contain hosts, use --force to delete them: "Web" (2 hosts)`)
+
+	err = checkHostGroupsDeletable([]zabbix.HostGroup{internal, used}, true)
+	assert.EqualError(t, err, `internal host group(s) cannot be deleted: "Discovered hosts"`)
+
+	err = checkHostGroupsDeletable([]zabbix.HostGroup{internal, used}, false)
+	assert.ErrorContains(t, err, "internal host group(s)")
+	assert.ErrorContains(t, err, "use --force")
+}
+
+func TestPrintHostGroupPreview(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	printHostGroupPreview(&out, []zabbix.HostGroup{
+		{GroupID: "11", Name: "Web", Hosts: []zabbix.Host{{HostID: "1"}, {HostID: "2"}}},
+		{GroupID: "5", Name: "Discovered hosts", Internal: "1"},
+	})
+	assert.Equal(t, `ID   NAME               HOSTS   INTERNAL
+11   Web                2       no
+5    Discovered hosts   0       yes
+`, out.String())
+}
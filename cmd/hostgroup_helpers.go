@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+)
+
+// getHostGroupsByIDOrName returns the host groups with the given IDs or exact names, in the
+// order given, with their hosts. Every ID or name must match a host group.
+func getHostGroupsByIDOrName(ctx context.Context, z *zabbix.Client, idsOrNames []string) ([]zabbix.HostGroup, error) {
+	response, err := z.HostGroupGet(ctx, zabbix.NewGetAllHostGroupsRequest(
+		zabbix.WithHostGroupGetSelectHosts([]string{"hostid", "host", "name", "status"}),
+		zabbix.WithHostGroupGetAuth(z.Auth()),
+		zabbix.WithHostGroupGetID(1),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host groups: %w", err)
+	}
+
+	groups, missing := selectHostGroups(response.Result, idsOrNames)
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("host group(s) %s: %w", strings.Join(missing, ", "), errNotFound)
+	}
+	return groups, nil
+}
+
+// selectHostGroups returns the groups with the given IDs or names, without duplicates, and the
+// IDs or names that match no group. Names take precedence over IDs, as group names can be numeric.
+func selectHostGroups(groups []zabbix.HostGroup, idsOrNames []string) ([]zabbix.HostGroup, []string) {
+	var selected []zabbix.HostGroup
+	var missing []string
+	seen := make(map[string]bool)
+	for _, idOrName := range idsOrNames {
+		match := -1
+		for i := range groups {
+			if groups[i].Name == idOrName {
+				match = i
+				break
+			}
+			if match < 0 && groups[i].GroupID == idOrName {
+				match = i
+			}
+		}
+		switch {
+		case match < 0:
+			missing = append(missing, idOrName)
+		case !seen[groups[match].GroupID]:
+			seen[groups[match].GroupID] = true
+			selected = append(selected, groups[match])
+		}
+	}
+	return selected, missing
+}
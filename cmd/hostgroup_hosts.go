@@ -0,0 +1,212 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	hostGroupHostsNames  []string
+	hostGroupHostsFile   string
+	hostGroupHostsGlobs  []string
+	hostGroupHostsDryRun bool
+	hostGroupHostsYes    bool
+)
+
+// HostGroupAddHostsCmd represents the hostgroup add-hosts command
+var HostGroupAddHostsCmd = &cobra.Command{
+	Use:   "add-hosts <group>",
+	Short: "Add hosts to a host group",
+	Long: `Add hosts to a host group, given by name or ID. The hosts keep their other groups.
+
+Hosts are given by name with --host, by glob with --host-glob ('*' wildcard), or listed one per
+line in a file with --from-file ("-" for stdin, '#' starts a comment). Hosts already in the group
+are skipped. A confirmation is asked when more than 5 hosts are added, unless --yes is given.
+
+Examples:
+  zabbix-cli hostgroup add-hosts "Prod/EU/Web" --host web01 --host web02
+  zabbix-cli hostgroup add-hosts "Prod/EU/Web" --host-glob 'web*' --dry-run
+  zabbix-cli hostgroup add-hosts "Prod/EU/Web" --from-file hosts.txt --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeHostGroupMembers(cmd, args[0], true)
+	},
+}
+
+// HostGroupRemoveHostsCmd represents the hostgroup remove-hosts command
+var HostGroupRemoveHostsCmd = &cobra.Command{
+	Use:   "remove-hosts <group>",
+	Short: "Remove hosts from a host group",
+	Long: `Remove hosts from a host group, given by name or ID. Zabbix refuses to remove a host
+from its only host group.
+
+Hosts are given as for add-hosts. Hosts that are not in the group are skipped. A confirmation
+is asked when more than 5 hosts are removed, unless --yes is given.
+
+Examples:
+  zabbix-cli hostgroup remove-hosts "Prod/EU/Web" --host web01
+  zabbix-cli hostgroup remove-hosts "Staging" --from-file decommissioned.txt`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeHostGroupMembers(cmd, args[0], false)
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{HostGroupAddHostsCmd, HostGroupRemoveHostsCmd} {
+		c.Flags().StringArrayVar(&hostGroupHostsNames, "host", nil, "Host name; repeatable")
+		c.Flags().StringArrayVar(&hostGroupHostsGlobs, "host-glob", nil, "Host name pattern ('*' wildcard); repeatable")
+		c.Flags().StringVar(&hostGroupHostsFile, "from-file", "", "File listing one host per line (\"-\" for stdin)")
+		c.Flags().BoolVar(&hostGroupHostsDryRun, "dry-run", false, "List the hosts that would be changed without changing them")
+		c.Flags().BoolVarP(&hostGroupHostsYes, "yes", "y", false, "Do not ask for confirmation")
+	}
+}
+
+// changeHostGroupMembers adds the hosts given by the flags to the group, or removes them from it.
+func changeHostGroupMembers(cmd *cobra.Command, group string, add bool) error {
+	ctx := context.Background()
+
+	patterns := append(append([]string{}, hostGroupHostsNames...), hostGroupHostsGlobs...)
+	if hostGroupHostsFile != "" {
+		names, err := readHostList(hostGroupHostsFile, cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
+		patterns = append(patterns, names...)
+	}
+	if len(patterns) == 0 {
+		return fmt.Errorf("no host given: use --host, --host-glob or --from-file")
+	}
+
+	z, logout, err := newClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer logout()
+
+	groups, err := getHostGroupsByIDOrName(ctx, z, []string{group})
+	if err != nil {
+		return err
+	}
+	hg := groups[0]
+	hosts, err := resolveHosts(ctx, z, patterns)
+	if err != nil {
+		return err
+	}
+
+	changed, skipped := planHostGroupMembers(&hg, hosts, add)
+	out := cmd.OutOrStdout()
+	verb, done, preposition, skipReason := "Add", "added", "to", "already in the group"
+	if !add {
+		verb, done, preposition, skipReason = "Remove", "removed", "from", "not in the group"
+	}
+	if len(skipped) > 0 {
+		fmt.Fprintf(out, "Skipping %d host(s) %s\n", len(skipped), skipReason)
+	}
+	if len(changed) == 0 {
+		fmt.Fprintf(out, "No host to %s %s host group %q\n", strings.ToLower(verb), preposition, hg.Name)
+		return nil
+	}
+
+	if hostGroupHostsDryRun || len(changed) > defaultConfirmThreshold {
+		printHostList(out, changed)
+	}
+	if hostGroupHostsDryRun {
+		fmt.Fprintf(out, "Dry run: %d host(s) would be %s %s host group %q\n", len(changed), done, preposition, hg.Name)
+		return nil
+	}
+	if len(changed) > defaultConfirmThreshold && !hostGroupHostsYes {
+		if !confirm(cmd.InOrStdin(), out, fmt.Sprintf("%s %d hosts %s host group %q?", verb, len(changed), preposition, hg.Name)) {
+			return fmt.Errorf("aborted by user")
+		}
+	}
+
+	hostIDs := make([]string, 0, len(changed))
+	for _, h := range changed {
+		hostIDs = append(hostIDs, h.HostID)
+	}
+	if add {
+		_, err = z.HostGroupMassAdd(ctx, zabbix.NewHostGroupMassAddRequest([]string{hg.GroupID}, hostIDs,
+			zabbix.WithHostGroupMassAddAuth(z.Auth()),
+			zabbix.WithHostGroupMassAddID(1),
+		))
+	} else {
+		_, err = z.HostGroupMassRemove(ctx, zabbix.NewHostGroupMassRemoveRequest([]string{hg.GroupID}, hostIDs,
+			zabbix.WithHostGroupMassRemoveAuth(z.Auth()),
+			zabbix.WithHostGroupMassRemoveID(1),
+		))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to %s hosts %s host group %q: %w", strings.ToLower(verb), preposition, hg.Name, err)
+	}
+
+	fmt.Fprintf(out, "Successfully %s %d host(s) %s host group %q\n", done, len(changed), preposition, hg.Name)
+	return nil
+}
+
+// planHostGroupMembers splits hosts into the hosts to add to the group (add) or to remove from it,
+// and the hosts to skip because they already are, or are not, members of the group.
+func planHostGroupMembers(group *zabbix.HostGroup, hosts []zabbix.Host, add bool) ([]zabbix.Host, []zabbix.Host) {
+	members := make(map[string]bool, len(group.Hosts))
+	for _, h := range group.Hosts {
+		members[h.HostID] = true
+	}
+	var changed, skipped []zabbix.Host
+	for _, h := range hosts {
+		if members[h.HostID] == add {
+			skipped = append(skipped, h)
+		} else {
+			changed = append(changed, h)
+		}
+	}
+	return changed, skipped
+}
+
+// readHostList reads host names from a file ("-" for stdin), one per line. Blank lines and
+// comments starting with '#' are ignored.
+func readHostList(path string, stdin io.Reader) ([]string, error) {
+	in := stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read %s: %w", path, err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	var names []string
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if line = strings.TrimSpace(line); line != "" {
+			names = append(names, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", path, err)
+	}
+	return names, nil
+}
+
+// printHostList prints hosts as a table.
+func printHostList(out io.Writer, hosts []zabbix.Host) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "HOSTID\tHOST\tNAME\tSTATUS")
+	for _, h := range hosts {
+		status := "enabled"
+		if !h.IsMonitored() {
+			status = "disabled"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", h.HostID, h.Host, h.Name, status)
+	}
+	w.Flush()
+}
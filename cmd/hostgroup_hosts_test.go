@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanHostGroupMembers(t *testing.T) {
+	t.Parallel()
+
+	group := &zabbix.HostGroup{GroupID: "11", Name: "Web", Hosts: []zabbix.Host{{HostID: "1"}, {HostID: "2"}}}
+	hosts := []zabbix.Host{{HostID: "1", Host: "web01"}, {HostID: "3", Host: "web03"}}
+	names := func(hosts []zabbix.Host) []string {
+		var result []string
+		for _, h := range hosts {
+			result = append(result, h.Host)
+		}
+		return result
+	}
+
+	changed, skipped := planHostGroupMembers(group, hosts, true)
+	assert.Equal(t, []string{"web03"}, names(changed))
+	assert.Equal(t, []string{"web01"}, names(skipped))
+
+	changed, skipped = planHostGroupMembers(group, hosts, false)
+	assert.Equal(t, []string{"web01"}, names(changed))
+	assert.Equal(t, []string{"web03"}, names(skipped))
+}
+
+func TestReadHostList(t *testing.T) {
+	t.Parallel()
+
+	content := "# web servers\nweb01\n  web02  # canary\n\nweb0*\n"
+	expected := []string{"web01", "web02", "web0*"}
+
+	names, err := readHostList("-", strings.NewReader(content))
+	require.NoError(t, err)
+	assert.Equal(t, expected, names)
+
+	path := filepath.Join(t.TempDir(), "hosts.txt")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	names, err = readHostList(path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, expected, names)
+
+	_, err = readHostList(filepath.Join(t.TempDir(), "missing.txt"), nil)
+	assert.Error(t, err)
+}
+
+func TestPrintHostList(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	printHostList(&out, []zabbix.Host{
+		{HostID: "10084", Host: "web01", Name: "Web 01", Status: zabbix.HostStatusMonitored},
+		{HostID: "10085", Host: "web02", Status: zabbix.HostStatusUnmonitored},
+	})
+	assert.Equal(t, `HOSTID   HOST    NAME     STATUS
+10084    web01   Web 01   enabled
+10085    web02            disabled
+`, out.String())
+}
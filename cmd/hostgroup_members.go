@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var hostGroupMembersOutput string
+
+// HostGroupMembersCmd represents the hostgroup members command
+var HostGroupMembersCmd = &cobra.Command{
+	Use:   "members <group>",
+	Short: "List the hosts of a host group",
+	Long: `List the hosts of a host group, given by name or ID.
+
+Examples:
+  zabbix-cli hostgroup members "Prod/EU/Web"
+  zabbix-cli hostgroup members "Prod/EU/Web" -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		groups, err := getHostGroupsByIDOrName(ctx, z, args)
+		if err != nil {
+			return err
+		}
+		hosts := groups[0].Hosts
+		sort.Slice(hosts, func(i, j int) bool { return strings.ToLower(hosts[i].Host) < strings.ToLower(hosts[j].Host) })
+
+		out := cmd.OutOrStdout()
+		if hostGroupMembersOutput == "json" {
+			jsonOutput, err := json.MarshalIndent(hosts, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal hosts to JSON: %w", err)
+			}
+			fmt.Fprintln(out, string(jsonOutput))
+			return nil
+		}
+		printHostList(out, hosts)
+		fmt.Fprintf(out, "Total hosts: %d\n", len(hosts))
+		return nil
+	},
+}
+
+func init() {
+	HostGroupMembersCmd.Flags().StringVarP(&hostGroupMembersOutput, "output", "o", "table", "Output format: table or json")
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+// HostGroupRenameCmd represents the hostgroup rename command
+var HostGroupRenameCmd = &cobra.Command{
+	Use:   "rename <old name|id> <new name>",
+	Short: "Rename a host group",
+	Long: `Rename a host group, given by name or ID. Subgroups are not renamed.
+
+Examples:
+  zabbix-cli hostgroup rename "Web servers" "Prod/EU/Web"`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		groups, err := getHostGroupsByIDOrName(ctx, z, args[:1])
+		if err != nil {
+			return err
+		}
+		group := groups[0]
+		if group.Name == args[1] {
+			fmt.Fprintf(cmd.OutOrStdout(), "Host group %q is already named %q\n", group.GroupID, args[1])
+			return nil
+		}
+
+		_, err = z.HostGroupUpdate(ctx, zabbix.NewHostGroupUpdateRequest(group.GroupID, args[1],
+			zabbix.WithHostGroupUpdateAuth(z.Auth()),
+			zabbix.WithHostGroupUpdateID(1),
+		))
+		if err != nil {
+			return fmt.Errorf("failed to rename host group %q: %w", group.Name, err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Renamed host group %q to %q (ID: %s)\n", group.Name, args[1], group.GroupID)
+		return nil
+	},
+}
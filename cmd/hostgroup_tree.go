@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+// hostGroupSeparator separates the levels of nested host group names.
+const hostGroupSeparator = "/"
+
+// Settings propagated to subgroups by 'hostgroup tree --propagate'.
+const (
+	propagatePermissions = "permissions"
+	propagateTagFilters  = "tag-filters"
+)
+
+var (
+	hostGroupTreePropagate []string
+	hostGroupTreeYes       bool
+)
+
+// hostGroupNode is a node of the host group tree. Nodes of intermediate levels that are not
+// host groups themselves, such as "Prod" for "Prod/EU", have no group.
+type hostGroupNode struct {
+	name     string // last level of the path
+	path     string
+	group    *zabbix.HostGroup
+	children []*hostGroupNode
+}
+
+// HostGroupTreeCmd represents the hostgroup tree command
+var HostGroupTreeCmd = &cobra.Command{
+	Use:   "tree [group]",
+	Short: "Show host groups as a tree",
+	Long: `Show host groups as a tree, nesting them by the "/" separator of their names, with the
+number of hosts of every group. Discovered host groups are marked. Levels that are not host
+groups themselves are marked "(no group)". Give a group to only show its subtree.
+
+--propagate applies the permissions and/or the tag filters of the user groups on the given
+group to all its subgroups (Zabbix 6.2 or later). A confirmation is asked unless --yes is given.
+
+Examples:
+  zabbix-cli hostgroup tree
+  zabbix-cli hostgroup tree Prod/EU
+  zabbix-cli hostgroup tree Prod --propagate permissions,tag-filters`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		for _, p := range hostGroupTreePropagate {
+			if p != propagatePermissions && p != propagateTagFilters {
+				return fmt.Errorf("invalid --propagate %q (expected '%s' or '%s')", p, propagatePermissions, propagateTagFilters)
+			}
+		}
+		if len(hostGroupTreePropagate) > 0 && len(args) == 0 {
+			return fmt.Errorf("--propagate requires a host group")
+		}
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		response, err := z.HostGroupGet(ctx, zabbix.NewGetAllHostGroupsRequest(
+			zabbix.WithHostGroupGetSelectHosts("count"),
+			zabbix.WithHostGroupGetAuth(z.Auth()),
+			zabbix.WithHostGroupGetID(1),
+		))
+		if err != nil {
+			return fmt.Errorf("failed to get host groups: %w", err)
+		}
+
+		root := buildHostGroupTree(response.Result)
+		if len(args) > 0 {
+			path := args[0]
+			if selected, _ := selectHostGroups(response.Result, args); len(selected) > 0 {
+				path = selected[0].Name
+			}
+			if root = root.find(path); root == nil {
+				return fmt.Errorf("host group %q: %w", args[0], errNotFound)
+			}
+		}
+
+		out := cmd.OutOrStdout()
+		if len(hostGroupTreePropagate) > 0 {
+			if err := propagateHostGroup(ctx, cmd, z, root); err != nil {
+				return err
+			}
+		}
+		printHostGroupTree(out, root)
+		return nil
+	},
+}
+
+func init() {
+	HostGroupTreeCmd.Flags().StringSliceVar(&hostGroupTreePropagate, "propagate", nil, "Propagate settings of the group to its subgroups: permissions, tag-filters")
+	HostGroupTreeCmd.Flags().BoolVarP(&hostGroupTreeYes, "yes", "y", false, "Do not ask for confirmation")
+}
+
+// propagateHostGroup propagates the settings selected by --propagate from the group of node to its subgroups.
+func propagateHostGroup(ctx context.Context, cmd *cobra.Command, z *zabbix.Client, node *hostGroupNode) error {
+	if node.group == nil {
+		return fmt.Errorf("%q is not a host group, settings can only be propagated from a host group", node.path)
+	}
+	version, err := z.APIVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get Zabbix version: %w", err)
+	}
+	minVersion := zabbix.HostGroupPropagateMinVersion
+	if !version.AtLeast(minVersion.Major, minVersion.Minor) {
+		return fmt.Errorf("--propagate requires Zabbix %d.%d or later (server is %s)", minVersion.Major, minVersion.Minor, version)
+	}
+
+	settings := strings.Join(hostGroupTreePropagate, " and ")
+	out := cmd.OutOrStdout()
+	subgroups := node.countGroups() - 1
+	if !hostGroupTreeYes && !confirm(cmd.InOrStdin(), out, fmt.Sprintf("Propagate %s of %q to %d subgroup(s)?", settings, node.path, subgroups)) {
+		return fmt.Errorf("aborted by user")
+	}
+
+	_, err = z.HostGroupPropagate(ctx, zabbix.NewHostGroupPropagateRequest([]string{node.group.GroupID},
+		zabbix.WithHostGroupPropagatePermissions(slices.Contains(hostGroupTreePropagate, propagatePermissions)),
+		zabbix.WithHostGroupPropagateTagFilters(slices.Contains(hostGroupTreePropagate, propagateTagFilters)),
+		zabbix.WithHostGroupPropagateAuth(z.Auth()),
+		zabbix.WithHostGroupPropagateID(1),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to propagate %s of %q: %w", settings, node.path, err)
+	}
+	fmt.Fprintf(out, "Propagated %s of %q to %d subgroup(s)\n", settings, node.path, subgroups)
+	return nil
+}
+
+// buildHostGroupTree returns the root of the tree of the host groups, nested by their names.
+// Children are sorted by name.
+func buildHostGroupTree(groups []zabbix.HostGroup) *hostGroupNode {
+	root := &hostGroupNode{}
+	for i := range groups {
+		node := root
+		var path []string
+		for _, level := range strings.Split(groups[i].Name, hostGroupSeparator) {
+			if level = strings.TrimSpace(level); level == "" {
+				continue
+			}
+			path = append(path, level)
+			child := node.child(level)
+			if child == nil {
+				child = &hostGroupNode{name: level, path: strings.Join(path, hostGroupSeparator)}
+				node.children = append(node.children, child)
+			}
+			node = child
+		}
+		if node != root {
+			node.group = &groups[i]
+		}
+	}
+	root.sort()
+	return root
+}
+
+// child returns the child of the node with the given name, or nil.
+func (n *hostGroupNode) child(name string) *hostGroupNode {
+	for _, c := range n.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// find returns the node of the subtree with the given path, or nil.
+func (n *hostGroupNode) find(path string) *hostGroupNode {
+	node := n
+	for _, level := range strings.Split(path, hostGroupSeparator) {
+		if level = strings.TrimSpace(level); level == "" {
+			continue
+		}
+		if node = node.child(level); node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+// sort sorts the children of the subtree by name, case-insensitively.
+func (n *hostGroupNode) sort() {
+	sort.Slice(n.children, func(i, j int) bool {
+		return strings.ToLower(n.children[i].name) < strings.ToLower(n.children[j].name)
+	})
+	for _, c := range n.children {
+		c.sort()
+	}
+}
+
+// countGroups returns the number of host groups of the subtree, including the node itself.
+func (n *hostGroupNode) countGroups() int {
+	count := 0
+	if n.group != nil {
+		count++
+	}
+	for _, c := range n.children {
+		count += c.countGroups()
+	}
+	return count
+}
+
+// label returns the description of the node in the tree.
+func (n *hostGroupNode) label(name string) string {
+	if n.group == nil {
+		return name + " (no group)"
+	}
+	unit := "hosts"
+	if n.group.HostCount == 1 {
+		unit = "host"
+	}
+	label := fmt.Sprintf("%s (%d %s)", name, n.group.HostCount, unit)
+	if n.group.IsDiscovered() {
+		label += " [discovered]"
+	}
+	return label
+}
+
+// printHostGroupTree prints the subtree of node. The root of the whole tree is not printed.
+func printHostGroupTree(out io.Writer, node *hostGroupNode) {
+	if node.path == "" {
+		for _, c := range node.children {
+			fmt.Fprintln(out, c.label(c.name))
+			printHostGroupChildren(out, c, "")
+		}
+		return
+	}
+	fmt.Fprintln(out, node.label(node.path))
+	printHostGroupChildren(out, node, "")
+}
+
+// printHostGroupChildren prints the children of node, indented by prefix.
+func printHostGroupChildren(out io.Writer, node *hostGroupNode, prefix string) {
+	for i, c := range node.children {
+		branch, indent := "├── ", "│   "
+		if i == len(node.children)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintln(out, prefix+branch+c.label(c.name))
+		printHostGroupChildren(out, c, prefix+indent)
+	}
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostGroupTree(t *testing.T) {
+	t.Parallel()
+
+	groups := []zabbix.HostGroup{
+		{GroupID: "1", Name: "Prod/EU/Web", HostCount: 20},
+		{GroupID: "2", Name: "Prod", HostCount: 0},
+		{GroupID: "3", Name: "Prod/EU/DB", HostCount: 1},
+		{GroupID: "4", Name: "Prod/US/Web", HostCount: 7, Flags: zabbix.HostGroupFlagDiscovered},
+		{GroupID: "5", Name: "Linux servers", HostCount: 42},
+	}
+	root := buildHostGroupTree(groups)
+
+	var out bytes.Buffer
+	printHostGroupTree(&out, root)
+	assert.Equal(t, `Linux servers (42 hosts)
+Prod (0 hosts)
+├── EU (no group)
+│   ├── DB (1 host)
+│   └── Web (20 hosts)
+└── US (no group)
+    └── Web (7 hosts) [discovered]
+`, out.String())
+	assert.Equal(t, 5, root.countGroups())
+
+	eu := root.find("Prod/EU")
+	require.NotNil(t, eu)
+	assert.Nil(t, eu.group)
+	assert.Equal(t, 2, eu.countGroups())
+	out.Reset()
+	printHostGroupTree(&out, eu)
+	assert.Equal(t, `Prod/EU (no group)
+├── DB (1 host)
+└── Web (20 hosts)
+`, out.String())
+
+	assert.Nil(t, root.find("Prod/Asia"))
+}
@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// secretMask replaces the value of secret macros in every output.
+const secretMask = "******"
+
+// macroTypes are the user macro types by name.
+var macroTypes = map[string]string{
+	"text":   zabbix.UserMacroTypeText,
+	"secret": zabbix.UserMacroTypeSecret,
+	"vault":  zabbix.UserMacroTypeVault,
+}
+
+// MacroCmd represents the macro command (list, set, unset, diff)
+var MacroCmd = &cobra.Command{
+	Use:   "macro",
+	Short: "Manage user macros",
+	Long:  `List, set and unset the user macros of hosts, templates or global macros, and diff host and template macros`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		// print help
+		cmd.Help() //nolint:errcheck
+		os.Exit(1)
+	},
+}
+
+// macroTargetFlags are the flags selecting the owner of the macros: a host, a template or the
+// global macros.
+type macroTargetFlags struct {
+	host     string
+	template string
+	global   bool
+}
+
+// macroTarget is the owner of macros: hostID is the ID of the host or template, empty for the
+// global macros.
+type macroTarget struct {
+	kind   string // host, template or global
+	name   string
+	hostID string
+}
+
+func (f *macroTargetFlags) addFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&f.host, "host", "", "Host owning the macros")
+	flags.StringVar(&f.template, "template", "", "Template owning the macros")
+	flags.BoolVar(&f.global, "global", false, "Global macros")
+}
+
+// resolve returns the target selected by the flags: exactly one of --host, --template and
+// --global must be given.
+func (f *macroTargetFlags) resolve(ctx context.Context, z *zabbix.Client) (*macroTarget, error) {
+	count := 0
+	for _, set := range []bool{f.host != "", f.template != "", f.global} {
+		if set {
+			count++
+		}
+	}
+	if count != 1 {
+		return nil, errors.New("exactly one of --host, --template and --global is required")
+	}
+
+	switch {
+	case f.global:
+		return &macroTarget{kind: "global"}, nil
+	case f.host != "":
+		hosts, err := resolveHosts(ctx, z, []string{f.host})
+		if err != nil {
+			return nil, err
+		}
+		if len(hosts) > 1 {
+			return nil, fmt.Errorf("%q matches %d hosts, give a single host", f.host, len(hosts))
+		}
+		return &macroTarget{kind: "host", name: hosts[0].Host, hostID: hosts[0].HostID}, nil
+	default:
+		templates, err := resolveTemplates(ctx, z, []string{f.template})
+		if err != nil {
+			return nil, err
+		}
+		if len(templates) > 1 {
+			return nil, fmt.Errorf("%q matches %d templates, give a single template", f.template, len(templates))
+		}
+		return &macroTarget{kind: "template", name: templates[0].Host, hostID: templates[0].TemplateID}, nil
+	}
+}
+
+// String returns the target as shown in messages, e.g. "host web01".
+func (t *macroTarget) String() string {
+	if t.kind == "global" {
+		return "global macros"
+	}
+	return t.kind + " " + t.name
+}
+
+// getMacros returns the macros of the target sorted by name.
+func getMacros(ctx context.Context, z *zabbix.Client, target *macroTarget) ([]zabbix.UserMacro, error) {
+	options := []zabbix.UserMacroGetOption{
+		zabbix.WithUserMacroGetSortField([]string{"macro"}),
+		zabbix.WithUserMacroGetAuth(z.Auth()),
+		zabbix.WithUserMacroGetID(1),
+	}
+	if target.kind == "global" {
+		options = append(options, zabbix.WithUserMacroGetGlobalMacro(true))
+	} else {
+		options = append(options, zabbix.WithUserMacroGetHostIDs([]string{target.hostID}))
+	}
+	response, err := z.UserMacroGet(ctx, zabbix.NewUserMacroGetRequest(options...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get macros of %s: %w", target, err)
+	}
+	macros := response.Result
+	sort.Slice(macros, func(i, j int) bool { return macros[i].Macro < macros[j].Macro })
+	return macros, nil
+}
+
+// normalizeMacroName returns the macro name in the {$NAME} form: NAME and $NAME are accepted.
+func normalizeMacroName(name string) string {
+	name = strings.TrimSpace(name)
+	if strings.HasPrefix(name, "{$") && strings.HasSuffix(name, "}") {
+		return name
+	}
+	return "{$" + strings.TrimPrefix(name, "$") + "}"
+}
+
+// parseMacroType returns the user macro type of a type name, empty for an empty name.
+func parseMacroType(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	macroType, ok := macroTypes[strings.ToLower(name)]
+	if !ok {
+		return "", fmt.Errorf("invalid macro type %q (expected text, secret or vault)", name)
+	}
+	return macroType, nil
+}
+
+// macroTypeName returns the name of a user macro type.
+func macroTypeName(macroType string) string {
+	switch macroType {
+	case zabbix.UserMacroTypeSecret:
+		return "secret"
+	case zabbix.UserMacroTypeVault:
+		return "vault"
+	default:
+		return "text"
+	}
+}
+
+// maskMacros returns a copy of macros where the values of secret macros are masked.
+func maskMacros(macros []zabbix.UserMacro) []zabbix.UserMacro {
+	masked := make([]zabbix.UserMacro, len(macros))
+	for i, m := range macros {
+		if m.IsSecret() {
+			m.Value = secretMask
+		}
+		masked[i] = m
+	}
+	return masked
+}
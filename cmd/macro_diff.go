@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+// Status of a macro in 'macro diff'.
+const (
+	macroOverridden = "overridden"
+	macroSame       = "same"
+	macroHostOnly   = "host only"
+	macroInherited  = "inherited"
+)
+
+var macroDiffOverridden bool
+
+// MacroDiffCmd represents the macro diff command
+var MacroDiffCmd = &cobra.Command{
+	Use:   "diff <host>",
+	Short: "Compare the macros of a host with the ones of its templates",
+	Long: `Compare the user macros of a host with the ones of its templates, directly or indirectly
+linked, to show which template defaults the host overrides. Like Zabbix, a macro defined on
+several templates takes the value of the closest template to the host, then of the template
+with the lowest ID.
+
+The status of each macro is one of:
+  overridden   defined on the host and a template, with a different value or type
+  same         defined on the host and a template, with the same value
+  host only    defined on the host only
+  inherited    defined on a template only
+
+Secret values are never shown nor compared: a secret macro on the host is always reported as
+overridden.
+
+Examples:
+  zabbix-cli macro diff web01
+  zabbix-cli macro diff web01 --overridden`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		host, err := getHostDetails(ctx, z, args[0])
+		if err != nil {
+			return err
+		}
+		templates, err := getTemplateChain(ctx, z, host.ParentTemplates)
+		if err != nil {
+			return err
+		}
+
+		diffs := diffMacros(host.Macros, templates)
+		if macroDiffOverridden {
+			overridden := diffs[:0]
+			for _, d := range diffs {
+				if d.Status == macroOverridden {
+					overridden = append(overridden, d)
+				}
+			}
+			diffs = overridden
+		}
+
+		out := cmd.OutOrStdout()
+		if len(diffs) == 0 {
+			fmt.Fprintf(out, "No macros to compare on host %s\n", host.Host)
+			return nil
+		}
+		printMacroDiff(out, diffs)
+		return nil
+	},
+}
+
+func init() {
+	MacroDiffCmd.Flags().BoolVar(&macroDiffOverridden, "overridden", false, "Only show the macros the host overrides")
+}
+
+// macroDiff is a macro of a host or its templates in 'macro diff'. Template is the name of the
+// template the host would inherit the macro from.
+type macroDiff struct {
+	Macro         string
+	HostValue     *zabbix.UserMacro
+	TemplateValue *zabbix.UserMacro
+	Template      string
+	Status        string
+}
+
+// getTemplateChain returns the templates linked to a host, with their macros, directly or
+// through other templates, in the order Zabbix resolves macros: level by level from the host,
+// by template ID within a level.
+func getTemplateChain(ctx context.Context, z *zabbix.Client, linked []zabbix.Template) ([]zabbix.Template, error) {
+	var chain []zabbix.Template
+	seen := make(map[string]bool)
+	var ids []string
+	for _, t := range linked {
+		if !seen[t.TemplateID] {
+			seen[t.TemplateID] = true
+			ids = append(ids, t.TemplateID)
+		}
+	}
+	for len(ids) > 0 {
+		response, err := z.TemplateGet(ctx, zabbix.NewTemplateGetRequest(
+			zabbix.WithTemplateGetTemplateIDs(ids),
+			zabbix.WithTemplateGetOutput([]string{"templateid", "host", "name"}),
+			zabbix.WithTemplateGetSelectParentTemplates([]string{"templateid"}),
+			zabbix.WithTemplateGetSelectMacros("extend"),
+			zabbix.WithTemplateGetAuth(z.Auth()),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get templates: %w", err)
+		}
+		level := response.Result
+		sort.Slice(level, func(i, j int) bool { return lessID(level[i].TemplateID, level[j].TemplateID) })
+		chain = append(chain, level...)
+
+		ids = nil
+		for _, t := range level {
+			for _, parent := range t.ParentTemplates {
+				if !seen[parent.TemplateID] {
+					seen[parent.TemplateID] = true
+					ids = append(ids, parent.TemplateID)
+				}
+			}
+		}
+	}
+	return chain, nil
+}
+
+// lessID compares two numeric Zabbix IDs.
+func lessID(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+// diffMacros compares the macros of a host with the ones of its templates, given in macro
+// resolution order. The result is sorted by macro name.
+func diffMacros(hostMacros []zabbix.UserMacro, templates []zabbix.Template) []macroDiff {
+	diffs := make(map[string]*macroDiff)
+	for i := range hostMacros {
+		m := &hostMacros[i]
+		diffs[m.Macro] = &macroDiff{Macro: m.Macro, HostValue: m}
+	}
+	for _, t := range templates {
+		for i := range t.Macros {
+			m := &t.Macros[i]
+			d, ok := diffs[m.Macro]
+			if !ok {
+				d = &macroDiff{Macro: m.Macro}
+				diffs[m.Macro] = d
+			}
+			if d.TemplateValue == nil {
+				d.TemplateValue = m
+				d.Template = t.Host
+			}
+		}
+	}
+
+	result := make([]macroDiff, 0, len(diffs))
+	for _, d := range diffs {
+		switch {
+		case d.TemplateValue == nil:
+			d.Status = macroHostOnly
+		case d.HostValue == nil:
+			d.Status = macroInherited
+		case d.HostValue.IsSecret() || d.TemplateValue.IsSecret() ||
+			macroTypeName(d.HostValue.Type) != macroTypeName(d.TemplateValue.Type) ||
+			d.HostValue.Value != d.TemplateValue.Value:
+			d.Status = macroOverridden
+		default:
+			d.Status = macroSame
+		}
+		result = append(result, *d)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Macro < result[j].Macro })
+	return result
+}
+
+// macroDiffValue returns the value of a macro in 'macro diff', masked for secret macros and "-"
+// for a missing macro.
+func macroDiffValue(m *zabbix.UserMacro) string {
+	switch {
+	case m == nil:
+		return "-"
+	case m.IsSecret():
+		return secretMask
+	default:
+		return m.Value
+	}
+}
+
+// printMacroDiff prints the result of 'macro diff' as a table.
+func printMacroDiff(out io.Writer, diffs []macroDiff) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "MACRO\tHOST VALUE\tTEMPLATE VALUE\tTEMPLATE\tSTATUS")
+	for _, d := range diffs {
+		template := d.Template
+		if template == "" {
+			template = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", d.Macro, macroDiffValue(d.HostValue), macroDiffValue(d.TemplateValue), template, d.Status)
+	}
+	w.Flush()
+}
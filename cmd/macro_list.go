@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	macroListTarget macroTargetFlags
+	macroListOutput string
+)
+
+// MacroListCmd represents the macro list command
+var MacroListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the macros of a host, a template or the global macros",
+	Long: `List the user macros defined on a host (--host), a template (--template) or globally
+(--global), with their type and description. Secret macro values are never shown.
+
+Examples:
+  zabbix-cli macro list --host web01
+  zabbix-cli macro list --template 'Linux by Zabbix agent'
+  zabbix-cli macro list --global -o json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		target, err := macroListTarget.resolve(ctx, z)
+		if err != nil {
+			return err
+		}
+		macros, err := getMacros(ctx, z, target)
+		if err != nil {
+			return err
+		}
+		macros = maskMacros(macros)
+
+		out := cmd.OutOrStdout()
+		if macroListOutput == "json" {
+			jsonOutput, err := json.MarshalIndent(macros, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal macros to JSON: %w", err)
+			}
+			fmt.Fprintln(out, string(jsonOutput))
+			return nil
+		}
+		if len(macros) == 0 {
+			fmt.Fprintf(out, "No macros on %s\n", target)
+			return nil
+		}
+		printMacroList(out, macros)
+		return nil
+	},
+}
+
+func init() {
+	macroListTarget.addFlags(MacroListCmd.Flags())
+	MacroListCmd.Flags().StringVarP(&macroListOutput, "output", "o", "table", "Output format: table or json")
+}
+
+// printMacroList prints macros, whose secret values must already be masked, as a table.
+func printMacroList(out io.Writer, macros []zabbix.UserMacro) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "MACRO\tVALUE\tTYPE\tDESCRIPTION")
+	for _, m := range macros {
+		line := fmt.Sprintf("%s\t%s\t%s", m.Macro, m.Value, macroTypeName(m.Type))
+		if m.Description != "" {
+			line += "\t" + m.Description
+		}
+		fmt.Fprintln(w, line)
+	}
+	w.Flush()
+}
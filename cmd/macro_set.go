@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	macroSetTarget      macroTargetFlags
+	macroSetType        string
+	macroSetDescription string
+)
+
+// MacroSetCmd represents the macro set command
+var MacroSetCmd = &cobra.Command{
+	Use:   "set <macro> [value]",
+	Short: "Create or update a macro",
+	Long: `Create or update a user macro on a host (--host), a template (--template) or globally
+(--global). The macro is given as {$NAME} or NAME. When the value is not given, it is read from
+stdin, which keeps secrets out of the shell history. The value is never printed.
+
+The type (--type text, secret or vault) and the description of an existing macro are kept
+unless given. The value of a vault macro is the path of the secret in the vault.
+
+Examples:
+  zabbix-cli macro set --host web01 '{$HTTP_PORT}' 8080
+  zabbix-cli macro set --global SNMP_COMMUNITY --type secret < community.txt
+  zabbix-cli macro set --template 'Linux by Zabbix agent' DB_PASSWORD --type vault secret/db:password`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		macroType, err := parseMacroType(macroSetType)
+		if err != nil {
+			return err
+		}
+		name := normalizeMacroName(args[0])
+		var value string
+		if len(args) == 2 {
+			value = args[1]
+		} else {
+			if value, err = readMacroValue(cmd.InOrStdin()); err != nil {
+				return err
+			}
+		}
+
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		target, err := macroSetTarget.resolve(ctx, z)
+		if err != nil {
+			return err
+		}
+		macros, err := getMacros(ctx, z, target)
+		if err != nil {
+			return err
+		}
+
+		macro := zabbix.UserMacro{Macro: name, Value: value, Type: macroType}
+		if cmd.Flags().Changed("description") {
+			macro.Description = macroSetDescription
+		}
+		existing := findMacro(macros, name)
+		out := cmd.OutOrStdout()
+		if existing != nil {
+			macro.HostMacroID = existing.HostMacroID
+			macro.GlobalMacroID = existing.GlobalMacroID
+			options := []zabbix.UserMacroUpdateOption{
+				zabbix.WithUserMacroUpdateAuth(z.Auth()),
+				zabbix.WithUserMacroUpdateID(1),
+			}
+			request := zabbix.NewUserMacroUpdateRequest(macro, options...)
+			if target.kind == "global" {
+				request = zabbix.NewGlobalMacroUpdateRequest(macro, options...)
+			}
+			if _, err := z.UserMacroUpdate(ctx, request); err != nil {
+				return fmt.Errorf("failed to update macro %s on %s: %w", name, target, err)
+			}
+			fmt.Fprintf(out, "Updated macro %s on %s\n", name, target)
+			return nil
+		}
+
+		macro.HostID = target.hostID
+		options := []zabbix.UserMacroCreateOption{
+			zabbix.WithUserMacroCreateAuth(z.Auth()),
+			zabbix.WithUserMacroCreateID(1),
+		}
+		request := zabbix.NewUserMacroCreateRequest(macro, options...)
+		if target.kind == "global" {
+			request = zabbix.NewGlobalMacroCreateRequest(macro, options...)
+		}
+		if _, err := z.UserMacroCreate(ctx, request); err != nil {
+			return fmt.Errorf("failed to create macro %s on %s: %w", name, target, err)
+		}
+		fmt.Fprintf(out, "Created macro %s on %s\n", name, target)
+		return nil
+	},
+}
+
+func init() {
+	macroSetTarget.addFlags(MacroSetCmd.Flags())
+	MacroSetCmd.Flags().StringVar(&macroSetType, "type", "", "Macro type: text, secret, vault (default text for a new macro)")
+	MacroSetCmd.Flags().StringVar(&macroSetDescription, "description", "", "Description of the macro")
+}
+
+// readMacroValue reads a macro value from in, without the trailing newline.
+func readMacroValue(in io.Reader) (string, error) {
+	data, err := io.ReadAll(in)
+	if err != nil {
+		return "", fmt.Errorf("failed to read macro value: %w", err)
+	}
+	value := strings.TrimRight(string(data), "\r\n")
+	if value == "" {
+		return "", errors.New("no macro value given on the command line or stdin")
+	}
+	return value, nil
+}
+
+// findMacro returns the macro with the given name, nil if there is none.
+func findMacro(macros []zabbix.UserMacro, name string) *zabbix.UserMacro {
+	for i := range macros {
+		if macros[i].Macro == name {
+			return &macros[i]
+		}
+	}
+	return nil
+}
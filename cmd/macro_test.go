@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeMacroName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "{$HTTP_PORT}", normalizeMacroName("HTTP_PORT"))
+	assert.Equal(t, "{$HTTP_PORT}", normalizeMacroName("$HTTP_PORT"))
+	assert.Equal(t, "{$HTTP_PORT}", normalizeMacroName(" {$HTTP_PORT} "))
+	assert.Equal(t, `{$LOW_SPACE:"/var"}`, normalizeMacroName(`LOW_SPACE:"/var"`))
+}
+
+func TestParseMacroType(t *testing.T) {
+	t.Parallel()
+
+	macroType, err := parseMacroType("Secret")
+	require.NoError(t, err)
+	assert.Equal(t, zabbix.UserMacroTypeSecret, macroType)
+	macroType, err = parseMacroType("")
+	require.NoError(t, err)
+	assert.Empty(t, macroType)
+	_, err = parseMacroType("password")
+	assert.Error(t, err)
+}
+
+func TestReadMacroValue(t *testing.T) {
+	t.Parallel()
+
+	value, err := readMacroValue(strings.NewReader("s3cr3t\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", value)
+	_, err = readMacroValue(strings.NewReader("\n"))
+	assert.Error(t, err)
+}
+
+func TestMaskMacros(t *testing.T) {
+	t.Parallel()
+
+	macros := []zabbix.UserMacro{
+		{Macro: "{$PASS}", Value: "s3cr3t", Type: zabbix.UserMacroTypeSecret},
+		{Macro: "{$PORT}", Value: "8080"},
+	}
+	masked := maskMacros(macros)
+	assert.Equal(t, secretMask, masked[0].Value)
+	assert.Equal(t, "8080", masked[1].Value)
+	assert.Equal(t, "s3cr3t", macros[0].Value)
+}
+
+func TestSelectMacros(t *testing.T) {
+	t.Parallel()
+
+	macros := []zabbix.UserMacro{{Macro: "{$MYSQL.HOST}"}, {Macro: "{$MYSQL.PORT}"}, {Macro: "{$PORT}"}}
+	selected, err := selectMacros(macros, []string{"PORT", "{$MYSQL.*}"})
+	require.NoError(t, err)
+	assert.Equal(t, macros, selected)
+
+	_, err = selectMacros(macros, []string{"PORT", "MISSING"})
+	require.ErrorIs(t, err, errNotFound)
+	assert.Contains(t, err.Error(), "{$MISSING}")
+}
+
+func TestDiffMacros(t *testing.T) {
+	t.Parallel()
+
+	hostMacros := []zabbix.UserMacro{
+		{Macro: "{$PORT}", Value: "8080"},
+		{Macro: "{$TIMEOUT}", Value: "10s"},
+		{Macro: "{$PASS}", Type: zabbix.UserMacroTypeSecret},
+		{Macro: "{$OWNER}", Value: "ops"},
+	}
+	templates := []zabbix.Template{
+		{Host: "App", Macros: []zabbix.UserMacro{{Macro: "{$PORT}", Value: "80"}, {Macro: "{$TIMEOUT}", Value: "10s"}}},
+		{Host: "Base", Macros: []zabbix.UserMacro{
+			{Macro: "{$PORT}", Value: "8000"},
+			{Macro: "{$PASS}", Type: zabbix.UserMacroTypeSecret},
+			{Macro: "{$INTERVAL}", Value: "1m"},
+		}},
+	}
+
+	diffs := diffMacros(hostMacros, templates)
+	require.Len(t, diffs, 5)
+	status := make(map[string]string)
+	for _, d := range diffs {
+		status[d.Macro] = d.Status + " " + d.Template
+	}
+	assert.Equal(t, map[string]string{
+		"{$INTERVAL}": "inherited Base",
+		"{$OWNER}":    "host only ",
+		"{$PASS}":     "overridden Base",
+		"{$PORT}":     "overridden App",
+		"{$TIMEOUT}":  "same App",
+	}, status)
+
+	var out bytes.Buffer
+	printMacroDiff(&out, diffs)
+	assert.Equal(t, `MACRO         HOST VALUE   TEMPLATE VALUE   TEMPLATE   STATUS
+{$INTERVAL}   -            1m               Base       inherited
+{$OWNER}      ops          -                -          host only
+{$PASS}       ******       ******           Base       overridden
+{$PORT}       8080         80               App        overridden
+{$TIMEOUT}    10s          10s              App        same
+`, out.String())
+}
+
+func TestLessID(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, lessID("999", "10001"))
+	assert.True(t, lessID("10001", "10002"))
+	assert.False(t, lessID("10002", "10002"))
+}
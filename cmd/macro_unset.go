@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	macroUnsetTarget macroTargetFlags
+	macroUnsetDryRun bool
+	macroUnsetYes    bool
+)
+
+// MacroUnsetCmd represents the macro unset command
+var MacroUnsetCmd = &cobra.Command{
+	Use:   "unset <macro...>",
+	Short: "Delete macros",
+	Long: `Delete user macros from a host (--host), a template (--template) or the global macros
+(--global). Macros are given as {$NAME}, NAME or glob ('*' wildcard).
+
+A confirmation is asked when more than 5 macros are deleted, unless --yes is given. Use
+--dry-run to only list them.
+
+Examples:
+  zabbix-cli macro unset --host web01 HTTP_PORT
+  zabbix-cli macro unset --template 'Linux by Zabbix agent' '{$MYSQL.*}' --dry-run`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		target, err := macroUnsetTarget.resolve(ctx, z)
+		if err != nil {
+			return err
+		}
+		macros, err := getMacros(ctx, z, target)
+		if err != nil {
+			return err
+		}
+		selected, err := selectMacros(macros, args)
+		if err != nil {
+			return fmt.Errorf("%s: %w", target, err)
+		}
+
+		out := cmd.OutOrStdout()
+		if macroUnsetDryRun || len(selected) > defaultConfirmThreshold {
+			for _, m := range selected {
+				fmt.Fprintf(out, "  %s\n", m.Macro)
+			}
+		}
+		if macroUnsetDryRun {
+			fmt.Fprintf(out, "Dry run: %d macro(s) would be deleted from %s\n", len(selected), target)
+			return nil
+		}
+		if len(selected) > defaultConfirmThreshold && !macroUnsetYes &&
+			!confirm(cmd.InOrStdin(), out, fmt.Sprintf("Delete %d macro(s) from %s?", len(selected), target)) {
+			return fmt.Errorf("aborted by user")
+		}
+
+		ids := make([]string, 0, len(selected))
+		for _, m := range selected {
+			if target.kind == "global" {
+				ids = append(ids, m.GlobalMacroID)
+			} else {
+				ids = append(ids, m.HostMacroID)
+			}
+		}
+		options := []zabbix.UserMacroDeleteOption{
+			zabbix.WithUserMacroDeleteAuth(z.Auth()),
+			zabbix.WithUserMacroDeleteID(1),
+		}
+		request := zabbix.NewUserMacroDeleteRequest(ids, options...)
+		if target.kind == "global" {
+			request = zabbix.NewGlobalMacroDeleteRequest(ids, options...)
+		}
+		if _, err := z.UserMacroDelete(ctx, request); err != nil {
+			return fmt.Errorf("failed to delete macros from %s: %w", target, err)
+		}
+
+		fmt.Fprintf(out, "Successfully deleted %d macro(s) from %s\n", len(selected), target)
+		return nil
+	},
+}
+
+func init() {
+	macroUnsetTarget.addFlags(MacroUnsetCmd.Flags())
+	MacroUnsetCmd.Flags().BoolVar(&macroUnsetDryRun, "dry-run", false, "List the macros that would be deleted without deleting them")
+	MacroUnsetCmd.Flags().BoolVarP(&macroUnsetYes, "yes", "y", false, "Do not ask for confirmation")
+}
+
+// selectMacros returns the macros matching the given names or globs, in the order of macros.
+// A name or glob matching no macro is an error.
+func selectMacros(macros []zabbix.UserMacro, patterns []string) ([]zabbix.UserMacro, error) {
+	selected := make(map[string]bool)
+	var missing []string
+	for _, pattern := range patterns {
+		pattern = normalizeMacroName(pattern)
+		found := false
+		for _, m := range macros {
+			if m.Macro == pattern || (isGlob(pattern) && globMatch(pattern, m.Macro)) {
+				selected[m.Macro] = true
+				found = true
+			}
+		}
+		if !found {
+			missing = append(missing, pattern)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("macro(s) %s: %w", strings.Join(missing, ", "), errNotFound)
+	}
+
+	result := make([]zabbix.UserMacro, 0, len(selected))
+	for _, m := range macros {
+		if selected[m.Macro] {
+			result = append(result, m)
+		}
+	}
+	return result, nil
+}
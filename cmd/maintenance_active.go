@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	maintenanceActiveHost string
+	maintenanceActiveAt   string
+)
+
+// activeMaintenance is a maintenance in effect for a host.
+type activeMaintenance struct {
+	maintenance *zabbix.Maintenance
+	occurrence  zabbix.Occurrence
+}
+
+// MaintenanceActiveCmd represents the maintenance active subcommand
+var MaintenanceActiveCmd = &cobra.Command{
+	Use:   "active --host <host> [--at <time>]",
+	Short: "Tell whether a host is in maintenance",
+	Long: `Tell whether a host is covered by a maintenance at a given time (default: now), directly or
+through one of its host groups, and by which maintenance. The time periods of the maintenances
+are evaluated locally, in the local time zone.
+
+The command exits with status 1 when the host is not in maintenance, so that it can be used
+in scripts.
+
+Examples:
+  zabbix-cli maintenance active --host web01
+  zabbix-cli maintenance active --host web01 --at "2026-10-20 22:30"
+  zabbix-cli maintenance active --host web01 --at 3h`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		inMaintenance, err := runMaintenanceActive(cmd)
+		if err != nil {
+			return err
+		}
+		if !inMaintenance {
+			return silentError(cmd, errNotInMaintenance)
+		}
+		return nil
+	},
+}
+
+func init() {
+	MaintenanceActiveCmd.Flags().StringVar(&maintenanceActiveHost, "host", "", "Host to check, by technical or visible name")
+	MaintenanceActiveCmd.Flags().StringVar(&maintenanceActiveAt, "at", "now", "Time to check (e.g., '2026-10-20 22:30', RFC3339, or a delay such as '2h')")
+	_ = MaintenanceActiveCmd.MarkFlagRequired("host")
+}
+
+// runMaintenanceActive prints the maintenances covering the host at the given time and
+// returns true if there is at least one.
+func runMaintenanceActive(cmd *cobra.Command) (bool, error) {
+	ctx := context.Background()
+
+	at, err := parseTimeOrDuration(maintenanceActiveAt, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("invalid --at: %w", err)
+	}
+
+	z, logout, err := newClient(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer logout()
+
+	host, err := getHostWithGroups(ctx, z, maintenanceActiveHost)
+	if err != nil {
+		return false, err
+	}
+	maintenances, err := getMaintenancesWithDetails(ctx, z)
+	if err != nil {
+		return false, err
+	}
+
+	active := activeMaintenancesForHost(maintenances, host, at)
+	printActiveMaintenances(cmd.OutOrStdout(), host, at, active)
+	return len(active) > 0, nil
+}
+
+// activeMaintenancesForHost returns the maintenances covering the host that are in effect at the given time.
+func activeMaintenancesForHost(maintenances []zabbix.Maintenance, host *zabbix.Host, at time.Time) []activeMaintenance {
+	var active []activeMaintenance
+	for i := range maintenances {
+		if !maintenanceCoversHost(&maintenances[i], host) {
+			continue
+		}
+		if o, ok := maintenances[i].ActiveAt(at); ok {
+			active = append(active, activeMaintenance{maintenance: &maintenances[i], occurrence: o})
+		}
+	}
+	return active
+}
+
+// printActiveMaintenances prints whether the host is in maintenance and the maintenances in effect.
+func printActiveMaintenances(out io.Writer, host *zabbix.Host, at time.Time, active []activeMaintenance) {
+	when := at.Format("2006-01-02 15:04:05 MST")
+	if len(active) == 0 {
+		fmt.Fprintf(out, "%s is not in maintenance at %s\n", host.Host, when)
+		return
+	}
+	fmt.Fprintf(out, "%s is in maintenance at %s:\n", host.Host, when)
+	for _, a := range active {
+		m := a.maintenance
+		kind := "with data collection"
+		if m.MaintenanceType == zabbix.MaintenanceNoDataCollection {
+			kind = "without data collection"
+		}
+		fmt.Fprintf(out, "  #%s %s: %s -> %s, %s\n", m.MaintenanceID, m.Name,
+			a.occurrence.Start.Format("2006-01-02 15:04"), a.occurrence.End.Format("2006-01-02 15:04"), kind)
+		if len(m.Tags) > 0 {
+			tags := make([]string, 0, len(m.Tags))
+			for _, t := range m.Tags {
+				tags = append(tags, formatProblemTag(t))
+			}
+			join := " and "
+			if m.TagsEvalType == zabbix.TagsEvalTypeOr {
+				join = " or "
+			}
+			fmt.Fprintf(out, "    only problems tagged %s\n", strings.Join(tags, join))
+		}
+	}
+}
+
+// formatProblemTag formats a maintenance problem tag as "key=value", or "key" without value.
+func formatProblemTag(t zabbix.ProblemTag) string {
+	if t.Value == "" {
+		return t.Tag
+	}
+	return t.Tag + "=" + t.Value
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveMaintenancesForHost(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, 10, 20, 2, 30, 0, 0, time.UTC)
+	since := zabbix.StringInt64(at.Add(-24 * time.Hour).Unix())
+	till := zabbix.StringInt64(at.Add(24 * time.Hour).Unix())
+	nightly := []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeDaily, Every: 1, StartTime: 2 * 3600, Period: 3600}}
+	maintenances := []zabbix.Maintenance{
+		{MaintenanceID: "1", Name: "by host", ActiveSince: since, ActiveTill: till, TimePeriods: nightly,
+			Hosts: []zabbix.Host{{HostID: "10084", Host: "web01"}}},
+		{MaintenanceID: "2", Name: "by group", ActiveSince: since, ActiveTill: till, TimePeriods: nightly,
+			Groups: []zabbix.HostGroup{{GroupID: "2", Name: "Linux servers"}},
+			Tags:   []zabbix.ProblemTag{{Tag: "service", Value: "nginx"}, {Tag: "env"}}, TagsEvalType: zabbix.TagsEvalTypeOr},
+		{MaintenanceID: "3", Name: "other group", ActiveSince: since, ActiveTill: till, TimePeriods: nightly,
+			Groups: []zabbix.HostGroup{{GroupID: "5", Name: "Windows"}}},
+		{MaintenanceID: "4", Name: "not now", ActiveSince: since, ActiveTill: till,
+			Hosts:       []zabbix.Host{{HostID: "10084", Host: "web01"}},
+			TimePeriods: []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeDaily, Every: 1, StartTime: 4 * 3600, Period: 3600}}},
+	}
+	host := &zabbix.Host{HostID: "10084", Host: "web01", HostGroups: []zabbix.HostGroup{{GroupID: "2"}, {GroupID: "4"}}}
+
+	active := activeMaintenancesForHost(maintenances, host, at)
+	var out bytes.Buffer
+	printActiveMaintenances(&out, host, at, active)
+	assert.Equal(t, `web01 is in maintenance at 2026-10-20 02:30:00 UTC:
+  #1 by host: 2026-10-20 02:00 -> 2026-10-20 03:00, with data collection
+  #2 by group: 2026-10-20 02:00 -> 2026-10-20 03:00, with data collection
+    only problems tagged service=nginx or env
+`, out.String())
+
+	out.Reset()
+	later := at.Add(time.Hour)
+	printActiveMaintenances(&out, host, later, activeMaintenancesForHost(maintenances, host, later))
+	assert.Equal(t, "web01 is not in maintenance at 2026-10-20 03:30:00 UTC\n", out.String())
+}
+
+func TestSilentError(t *testing.T) {
+	t.Parallel()
+
+	var stderr bytes.Buffer
+	cmd := &cobra.Command{
+		Use:  "active",
+		RunE: func(cmd *cobra.Command, _ []string) error { return silentError(cmd, errNotInMaintenance) },
+	}
+	cmd.SetErr(&stderr)
+	cmd.SetOut(&stderr)
+	cmd.SetArgs([]string{})
+
+	assert.ErrorIs(t, cmd.Execute(), errNotInMaintenance)
+	assert.Empty(t, stderr.String())
+}
+
+func TestActiveMaintenancesFromServer(t *testing.T) {
+	t.Parallel()
+	ts := newMaintenanceTestServer(t)
+	defer ts.Close()
+	client := zabbix.New("user", "pass", ts.URL)
+
+	maintenances, err := getMaintenancesWithDetails(context.Background(), &client)
+	require.NoError(t, err)
+
+	web01 := &zabbix.Host{HostID: "10084", Host: "web01"}
+	at := time.Date(2025, 10, 20, 2, 30, 0, 0, time.UTC)
+	active := activeMaintenancesForHost(maintenances, web01, at)
+	require.Len(t, active, 1)
+	assert.Equal(t, "3", active[0].maintenance.MaintenanceID)
+	assert.Equal(t, time.Date(2025, 10, 20, 3, 30, 0, 0, time.UTC), active[0].occurrence.End)
+
+	assert.Empty(t, activeMaintenancesForHost(maintenances, web01, at.Add(2*time.Hour)))
+}
@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	maintenanceApplyFile   string
+	maintenanceApplyPrune  bool
+	maintenanceApplyDryRun bool
+	maintenanceApplyYes    bool
+)
+
+// Kinds of changes planned by 'maintenance apply'.
+const (
+	applyCreate    = "create"
+	applyUpdate    = "update"
+	applyDelete    = "delete"
+	applyUnchanged = "unchanged"
+)
+
+// applySymbols prefix the plan lines of each kind of change.
+var applySymbols = map[string]string{
+	applyCreate:    "+",
+	applyUpdate:    "~",
+	applyDelete:    "-",
+	applyUnchanged: "=",
+}
+
+// applyAction is a change planned by 'maintenance apply'.
+type applyAction struct {
+	kind    string
+	name    string
+	changes []string            // changed fields, for updates
+	desired *zabbix.Maintenance // for creates and updates
+	current *zabbix.Maintenance // for updates and deletes
+}
+
+// MaintenanceApplyCmd represents the maintenance apply subcommand
+var MaintenanceApplyCmd = &cobra.Command{
+	Use:   "apply -f <file>",
+	Short: "Create, update or delete maintenances to match a YAML file",
+	Long: `Converge the maintenances of the server to the ones described in a YAML file.
+
+Maintenances are matched by name: missing ones are created, differing ones are updated, and
+with --prune the maintenances that are not in the file are deleted. The plan is printed
+first; --dry-run stops there. Deletions ask for a confirmation unless --yes is given.
+
+Hosts and groups are referenced by name. 'zabbix-cli maintenance get -o yaml' writes files
+in the same format:
+
+  maintenances:
+    - name: Patch Tuesday
+      description: Monthly OS updates
+      data_collection: true
+      active_since: "2026-01-01 00:00:00"
+      active_till: "2027-01-01 00:00:00"
+      groups: [Linux servers]
+      hosts: [db01]
+      tags:
+        - tag: service
+          value: os
+          operator: equals           # contains (default) or equals
+      periods:
+        - type: monthly-by-weekday   # one-time, daily, weekly, monthly, monthly-by-weekday or yearly
+          every: 2                   # week of the month for monthly-by-weekday (5 = last)
+          day_of_week: tue
+          at: "22:00"
+          duration: 2h
+
+Examples:
+  zabbix-cli maintenance apply -f windows.yaml --dry-run
+  zabbix-cli maintenance apply -f windows.yaml --prune --yes`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx := context.Background()
+		out := cmd.OutOrStdout()
+
+		specs, err := readMaintenanceSpecs(maintenanceApplyFile, cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		desired := make([]zabbix.Maintenance, 0, len(specs))
+		for i := range specs {
+			m, err := specs[i].toMaintenance(ctx, z)
+			if err != nil {
+				return err
+			}
+			desired = append(desired, m)
+		}
+
+		current, err := getMaintenancesWithDetails(ctx, z)
+		if err != nil {
+			return err
+		}
+
+		actions := planMaintenanceApply(current, desired, maintenanceApplyPrune)
+		counts := printApplyPlan(out, actions)
+		if maintenanceApplyDryRun {
+			fmt.Fprintln(out, "Dry run: no change made")
+			return nil
+		}
+		if counts[applyCreate]+counts[applyUpdate]+counts[applyDelete] == 0 {
+			return nil
+		}
+		if counts[applyDelete] > 0 && !maintenanceApplyYes &&
+			!confirm(cmd.InOrStdin(), out, fmt.Sprintf("Delete %d maintenance period(s)?", counts[applyDelete])) {
+			return fmt.Errorf("aborted by user")
+		}
+		return applyMaintenanceActions(ctx, out, z, actions)
+	},
+}
+
+func init() {
+	MaintenanceApplyCmd.Flags().StringVarP(&maintenanceApplyFile, "file", "f", "", "YAML file describing the maintenances ('-' for stdin)")
+	MaintenanceApplyCmd.Flags().BoolVar(&maintenanceApplyPrune, "prune", false, "Delete the maintenances that are not in the file")
+	MaintenanceApplyCmd.Flags().BoolVar(&maintenanceApplyDryRun, "dry-run", false, "Print the plan without applying it")
+	MaintenanceApplyCmd.Flags().BoolVarP(&maintenanceApplyYes, "yes", "y", false, "Do not ask for confirmation before deleting maintenances")
+	_ = MaintenanceApplyCmd.MarkFlagRequired("file")
+}
+
+// planMaintenanceApply matches the desired maintenances with the current ones by name and returns
+// the changes needed to converge, in the order of the file followed by the deletions.
+func planMaintenanceApply(current, desired []zabbix.Maintenance, prune bool) []applyAction {
+	byName := make(map[string]*zabbix.Maintenance, len(current))
+	for i := range current {
+		byName[current[i].Name] = &current[i]
+	}
+
+	actions := make([]applyAction, 0, len(desired))
+	wanted := make(map[string]bool, len(desired))
+	for i := range desired {
+		d := &desired[i]
+		wanted[d.Name] = true
+		c, ok := byName[d.Name]
+		if !ok {
+			actions = append(actions, applyAction{kind: applyCreate, name: d.Name, desired: d})
+			continue
+		}
+		params := c.UpdateParams()
+		changes := maintenanceDiff(&params, d)
+		kind := applyUnchanged
+		if len(changes) > 0 {
+			kind = applyUpdate
+		}
+		actions = append(actions, applyAction{kind: kind, name: d.Name, changes: changes, desired: d, current: c})
+	}
+
+	if prune {
+		var deleted []applyAction
+		for i := range current {
+			if !wanted[current[i].Name] {
+				deleted = append(deleted, applyAction{kind: applyDelete, name: current[i].Name, current: &current[i]})
+			}
+		}
+		sort.Slice(deleted, func(i, j int) bool { return deleted[i].name < deleted[j].name })
+		actions = append(actions, deleted...)
+	}
+	return actions
+}
+
+// printApplyPlan prints the planned changes and a summary, and returns the number of changes of each kind.
+func printApplyPlan(out io.Writer, actions []applyAction) map[string]int {
+	counts := make(map[string]int, len(applySymbols))
+	for _, a := range actions {
+		counts[a.kind]++
+		line := fmt.Sprintf("%s %s %q", applySymbols[a.kind], a.kind, a.name)
+		if a.current != nil {
+			line += fmt.Sprintf(" (ID %s)", a.current.MaintenanceID)
+		}
+		if len(a.changes) > 0 {
+			line += ": " + strings.Join(a.changes, ", ")
+		}
+		fmt.Fprintln(out, line)
+	}
+	fmt.Fprintf(out, "Plan: %d to create, %d to update, %d to delete, %d unchanged\n",
+		counts[applyCreate], counts[applyUpdate], counts[applyDelete], counts[applyUnchanged])
+	return counts
+}
+
+// applyMaintenanceActions creates, updates and deletes the maintenances, stopping at the first error.
+func applyMaintenanceActions(ctx context.Context, out io.Writer, z *zabbix.Client, actions []applyAction) error {
+	var deleteIDs []string
+	for _, a := range actions {
+		switch a.kind {
+		case applyCreate:
+			id, err := createMaintenance(ctx, z, a.desired)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Maintenance %s (%s) created\n", id, a.name)
+		case applyUpdate:
+			m := *a.desired
+			m.MaintenanceID = a.current.MaintenanceID
+			// Send empty lists so that hosts, groups and tags removed from the file are removed.
+			if m.HostIDs == nil {
+				m.HostIDs = []string{}
+			}
+			if m.GroupIDs == nil {
+				m.GroupIDs = []string{}
+			}
+			if m.Tags == nil {
+				m.Tags = []zabbix.ProblemTag{}
+			}
+			if err := updateMaintenance(ctx, z, m); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Maintenance %s (%s) updated\n", m.MaintenanceID, a.name)
+		case applyDelete:
+			deleteIDs = append(deleteIDs, a.current.MaintenanceID)
+		}
+	}
+
+	if len(deleteIDs) > 0 {
+		if _, err := z.MaintenanceDelete(ctx, zabbix.NewMaintenanceDeleteRequest(
+			zabbix.WithMaintenanceDeleteIDs(deleteIDs),
+			zabbix.WithMaintenanceDeleteAuthToken(z.Auth()),
+		)); err != nil {
+			return fmt.Errorf("failed to delete maintenance periods: %w", err)
+		}
+		fmt.Fprintf(out, "Maintenance(s) %s deleted\n", strings.Join(deleteIDs, ", "))
+	}
+	return nil
+}
+
+// createMaintenance creates the maintenance and returns its ID.
+func createMaintenance(ctx context.Context, z *zabbix.Client, m *zabbix.Maintenance) (string, error) {
+	options := []zabbix.MaintenanceCreateOption{
+		zabbix.WithMaintenanceName(m.Name),
+		zabbix.WithMaintenanceDescription(m.Description),
+		zabbix.WithMaintenanceActiveSince(m.ActiveSince.Int64()),
+		zabbix.WithMaintenanceActiveTill(m.ActiveTill.Int64()),
+		zabbix.WithMaintenanceType(m.MaintenanceType),
+		zabbix.WithMaintenanceTimePeriods(m.TimePeriods),
+		zabbix.WithMaintenanceGroupIDs(m.GroupIDs),
+		zabbix.WithMaintenanceHostIDs(m.HostIDs),
+		zabbix.WithMaintenanceAuthToken(z.Auth()),
+		zabbix.WithMaintenanceRequestID(1),
+	}
+	if len(m.Tags) > 0 {
+		options = append(options, zabbix.WithMaintenanceTags(m.Tags), zabbix.WithMaintenanceTagsEvalType(m.TagsEvalType))
+	}
+	response, err := z.MaintenanceCreate(ctx, zabbix.NewMaintenanceCreateRequest(options...))
+	if err != nil {
+		return "", fmt.Errorf("failed to create maintenance %q: %w", m.Name, err)
+	}
+	if len(response.Result.MaintenanceIDs) == 0 {
+		return "", fmt.Errorf("failed to create maintenance %q: %w", m.Name, zabbix.ErrEmptyResult)
+	}
+	return response.Result.MaintenanceIDs[0], nil
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanMaintenanceApply(t *testing.T) {
+	t.Parallel()
+
+	daily := []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeDaily, Every: 1, StartTime: 3600, Period: 600}}
+	current := []zabbix.Maintenance{
+		{MaintenanceID: "1", Name: "backup", ActiveSince: 1000, ActiveTill: 2000, TimePeriods: daily,
+			Hosts: []zabbix.Host{{HostID: "10084", Host: "db01"}}},
+		{MaintenanceID: "2", Name: "patching", ActiveSince: 1000, ActiveTill: 2000, TimePeriods: daily,
+			Groups: []zabbix.HostGroup{{GroupID: "2", Name: "Linux servers"}}},
+		{MaintenanceID: "3", Name: "old", ActiveSince: 1000, ActiveTill: 2000, TimePeriods: daily,
+			Groups: []zabbix.HostGroup{{GroupID: "2", Name: "Linux servers"}}},
+	}
+	desired := []zabbix.Maintenance{
+		{Name: "patching", ActiveSince: 1000, ActiveTill: 5000, TimePeriods: daily, GroupIDs: []string{"2"}},
+		{Name: "backup", ActiveSince: 1000, ActiveTill: 2000, TimePeriods: daily, HostIDs: []string{"10084"}},
+		{Name: "deploy", ActiveSince: 1000, ActiveTill: 2000, TimePeriods: daily, HostIDs: []string{"10084"}},
+	}
+
+	summary := func(actions []applyAction) []string {
+		out := []string{}
+		for _, a := range actions {
+			out = append(out, a.kind+" "+a.name)
+		}
+		return out
+	}
+
+	actions := planMaintenanceApply(current, desired, false)
+	assert.Equal(t, []string{"update patching", "unchanged backup", "create deploy"}, summary(actions))
+	assert.Equal(t, []string{"active_till"}, actions[0].changes)
+	assert.Equal(t, "2", actions[0].current.MaintenanceID)
+
+	actions = planMaintenanceApply(current, desired, true)
+	assert.Equal(t, []string{"update patching", "unchanged backup", "create deploy", "delete old"}, summary(actions))
+
+	var out bytes.Buffer
+	counts := printApplyPlan(&out, actions)
+	assert.Equal(t, map[string]int{applyCreate: 1, applyUpdate: 1, applyDelete: 1, applyUnchanged: 1}, counts)
+	assert.Equal(t, `~ update "patching" (ID 2): active_till
+= unchanged "backup" (ID 1)
++ create "deploy"
+- delete "old" (ID 3)
+Plan: 1 to create, 1 to update, 1 to delete, 1 unchanged
+`, out.String())
+}
+
+func TestPlanMaintenanceApplyFromServer(t *testing.T) {
+	t.Parallel()
+	ts := newMaintenanceTestServer(t)
+	defer ts.Close()
+	client := zabbix.New("user", "pass", ts.URL)
+
+	current, err := getMaintenancesWithDetails(context.Background(), &client)
+	require.NoError(t, err)
+
+	// The maintenance described by its own spec, as 'maintenance get -o yaml' then 'apply' do.
+	spec := specFromMaintenance(&current[0])
+	desired := zabbix.Maintenance{
+		Name: spec.Name, Description: spec.Description,
+		ActiveSince: current[0].ActiveSince, ActiveTill: current[0].ActiveTill,
+		HostIDs: []string{"10084"}, GroupIDs: []string{"2"},
+	}
+	for i := range spec.Periods {
+		tp, err := spec.Periods[i].toTimePeriod()
+		require.NoError(t, err)
+		desired.TimePeriods = append(desired.TimePeriods, tp)
+	}
+	for _, tag := range spec.Tags {
+		problemTag, err := tag.problemTag()
+		require.NoError(t, err)
+		desired.Tags = append(desired.Tags, problemTag)
+	}
+
+	actions := planMaintenanceApply(current, []zabbix.Maintenance{desired}, false)
+	require.Len(t, actions, 1)
+	assert.Equal(t, applyUnchanged, actions[0].kind, actions[0].changes)
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	maintenanceCalendarFrom string
+	maintenanceCalendarTo   string
+	maintenanceCalendarHost string
+)
+
+// calendarEntry is an occurrence of a maintenance in the calendar.
+type calendarEntry struct {
+	zabbix.Occurrence
+	maintenance *zabbix.Maintenance
+}
+
+// MaintenanceCalendarCmd represents the maintenance calendar subcommand
+var MaintenanceCalendarCmd = &cobra.Command{
+	Use:   "calendar",
+	Short: "Print when maintenances are in effect",
+	Long: `Expand the time periods of every maintenance and print the resulting windows between --from
+and --to, day by day. Windows are computed locally, in the local time zone, and clipped to the
+active window of each maintenance.
+
+--from and --to accept absolute times ('2026-10-20 22:00', RFC3339) or durations: --from is
+relative to now and --to relative to --from.
+
+Examples:
+  zabbix-cli maintenance calendar
+  zabbix-cli maintenance calendar --from 2026-11-01 --to 30d
+  zabbix-cli maintenance calendar --host web01`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx := context.Background()
+
+		now := time.Now()
+		from, err := parseTimeOrDuration(maintenanceCalendarFrom, now)
+		if err != nil {
+			return fmt.Errorf("invalid --from: %w", err)
+		}
+		to, err := parseTimeOrDuration(maintenanceCalendarTo, from)
+		if err != nil {
+			return fmt.Errorf("invalid --to: %w", err)
+		}
+		if !to.After(from) {
+			return fmt.Errorf("--to must be after --from")
+		}
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		maintenances, err := getMaintenancesWithDetails(ctx, z)
+		if err != nil {
+			return err
+		}
+		if maintenanceCalendarHost != "" {
+			host, err := getHostWithGroups(ctx, z, maintenanceCalendarHost)
+			if err != nil {
+				return err
+			}
+			var covering []zabbix.Maintenance
+			for i := range maintenances {
+				if maintenanceCoversHost(&maintenances[i], host) {
+					covering = append(covering, maintenances[i])
+				}
+			}
+			maintenances = covering
+		}
+
+		printMaintenanceCalendar(cmd.OutOrStdout(), maintenances, from, to)
+		return nil
+	},
+}
+
+func init() {
+	MaintenanceCalendarCmd.Flags().StringVar(&maintenanceCalendarFrom, "from", "now", "Start of the calendar (time, or delay from now such as '1d')")
+	MaintenanceCalendarCmd.Flags().StringVar(&maintenanceCalendarTo, "to", "7d", "End of the calendar (time, or duration after --from such as '30d')")
+	MaintenanceCalendarCmd.Flags().StringVar(&maintenanceCalendarHost, "host", "", "Only show the maintenances covering this host, directly or through its groups")
+}
+
+// printMaintenanceCalendar prints the occurrences of the maintenances overlapping [from, to), grouped by day.
+func printMaintenanceCalendar(out io.Writer, maintenances []zabbix.Maintenance, from, to time.Time) {
+	var entries []calendarEntry
+	for i := range maintenances {
+		for _, o := range maintenances[i].Occurrences(from, to) {
+			entries = append(entries, calendarEntry{Occurrence: o, maintenance: &maintenances[i]})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Start.Before(entries[j].Start) })
+
+	fmt.Fprintf(out, "Maintenance windows from %s to %s\n", from.Format("Mon 2006-01-02 15:04"), to.Format("Mon 2006-01-02 15:04"))
+	if len(entries) == 0 {
+		fmt.Fprintln(out, "No maintenance window")
+		return
+	}
+
+	day := ""
+	for _, e := range entries {
+		if d := e.Start.Format("Mon 2006-01-02"); d != day {
+			day = d
+			fmt.Fprintf(out, "\n%s\n", day)
+		}
+		// Windows ending on a later day are marked with the number of days, e.g. 02:00+1.
+		end := e.End.Format("15:04")
+		if days := daysBetweenDates(e.Start, e.End); days > 0 {
+			end += fmt.Sprintf("+%d", days)
+		}
+		kind := ""
+		if e.maintenance.MaintenanceType == zabbix.MaintenanceNoDataCollection {
+			kind = " [no data]"
+		}
+		fmt.Fprintf(out, "  %s-%-8s %-9s %s (#%s)%s\n", e.Start.Format("15:04"), end, formatDuration(e.End.Sub(e.Start)),
+			e.maintenance.Name, e.maintenance.MaintenanceID, kind)
+	}
+}
+
+// daysBetweenDates returns the number of calendar days from the day of a to the day of b.
+func daysBetweenDates(a, b time.Time) int {
+	da := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	db := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(db.Sub(da).Hours() / 24)
+}
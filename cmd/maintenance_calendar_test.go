@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintMaintenanceCalendar(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC) // Monday
+	maintenances := []zabbix.Maintenance{
+		{MaintenanceID: "12", Name: "Backup", MaintenanceType: zabbix.MaintenanceNoDataCollection,
+			ActiveSince: zabbix.StringInt64(from.Unix()), ActiveTill: zabbix.StringInt64(from.AddDate(1, 0, 0).Unix()),
+			TimePeriods: []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeWeekly, Every: 1, DayOfWeek: 2, StartTime: 23 * 3600, Period: 3 * 3600}}},
+		{MaintenanceID: "13", Name: "Deploy",
+			ActiveSince: zabbix.StringInt64(from.Unix()), ActiveTill: zabbix.StringInt64(from.AddDate(0, 0, 2).Unix()),
+			TimePeriods: []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: from.Add(26 * time.Hour).Unix(), Period: 5400}}},
+	}
+
+	var out bytes.Buffer
+	printMaintenanceCalendar(&out, maintenances, from, from.AddDate(0, 0, 7))
+	assert.Equal(t, `Maintenance windows from Mon 2026-10-19 00:00 to Mon 2026-10-26 00:00
+
+Tue 2026-10-20
+  02:00-03:30    1h30m     Deploy (#13)
+  23:00-02:00+1  3h        Backup (#12) [no data]
+`, out.String())
+
+	out.Reset()
+	printMaintenanceCalendar(&out, maintenances, from.AddDate(0, 0, 3), from.AddDate(0, 0, 4))
+	assert.Equal(t, "Maintenance windows from Thu 2026-10-22 00:00 to Fri 2026-10-23 00:00\nNo maintenance window\n", out.String())
+}
+
+func TestMaintenanceCalendarFromServer(t *testing.T) {
+	t.Parallel()
+	ts := newMaintenanceTestServer(t)
+	defer ts.Close()
+	client := zabbix.New("user", "pass", ts.URL)
+
+	maintenances, err := getMaintenancesWithDetails(context.Background(), &client)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	from := time.Date(2025, 10, 19, 0, 0, 0, 0, time.UTC)
+	printMaintenanceCalendar(&out, maintenances, from, from.AddDate(0, 0, 9))
+	assert.Equal(t, `Maintenance windows from Sun 2025-10-19 00:00 to Tue 2025-10-28 00:00
+
+Mon 2025-10-20
+  02:00-03:30    1h30m     Weekly patching (#3)
+  22:40-23:40    1h        Deploy (#4) [no data]
+
+Mon 2025-10-27
+  02:00-03:30    1h30m     Weekly patching (#3)
+`, out.String())
+}
@@ -2,8 +2,8 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
@@ -13,75 +13,96 @@ import (
 var (
 	maintenanceName        string
 	maintenanceDescription string
-	maintenanceDuration    int
+	maintenanceSched       maintenanceSchedule
+	maintenanceDryRun      bool
 	maintenanceType        zabbix.MaintenanceType
+	maintenanceHosts       []string
+	maintenanceGroups      []string
+	maintenanceTags        []string
+	maintenanceTagsEval    string
+	maintenanceAllGroups   bool
 )
 
+// errNoMaintenanceTarget is returned when a maintenance would not cover any host or host group.
+var errNoMaintenanceTarget = errors.New("no maintenance target: use --host and/or --group, or --all-groups to cover every host group")
+
 func init() {
 	// Add flags for maintenance create command
 	MaintenanceCreateCmd.Flags().StringVarP(&maintenanceName, "name", "n", "", "Name of the maintenance period (default: 'Maintenance <timestamp>')")
 	MaintenanceCreateCmd.Flags().StringVarP(&maintenanceDescription, "description", "d", "", "Description of the maintenance period (default: 'Maintenance created by zabbix-cli on <timestamp>')")
-	MaintenanceCreateCmd.Flags().IntVarP(&maintenanceDuration, "duration", "D", 1, "Duration of the maintenance period in hours")
+	maintenanceSched.addFlags(MaintenanceCreateCmd.Flags())
+	MaintenanceCreateCmd.Flags().BoolVar(&maintenanceDryRun, "dry-run", false, "Print the schedule and the next occurrences without creating the maintenance")
 	MaintenanceCreateCmd.Flags().IntVarP((*int)(&maintenanceType), "type", "t", int(zabbix.MaintenanceWithDataCollection), "Type of maintenance (0 - with data collection, 1 - without data collection)")
+	MaintenanceCreateCmd.Flags().StringArrayVar(&maintenanceHosts, "host", nil, "Host to put in maintenance, by name or glob (e.g., 'web*'); repeatable")
+	MaintenanceCreateCmd.Flags().StringArrayVar(&maintenanceGroups, "group", nil, "Host group to put in maintenance, by name or glob (e.g., 'Linux*'); repeatable")
+	MaintenanceCreateCmd.Flags().StringArrayVar(&maintenanceTags, "tag", nil, "Only put problems with this tag in maintenance (key=value); repeatable")
+	MaintenanceCreateCmd.Flags().StringVar(&maintenanceTagsEval, "tags-eval", "and", "How --tag values are combined: 'and' or 'or'")
+	MaintenanceCreateCmd.Flags().BoolVar(&maintenanceAllGroups, "all-groups", false, "Put every host group in maintenance")
 }
 
-// MaintenanceCreateCmd represents the create subcommand that creates maintenance for all host groups
+// MaintenanceCreateCmd represents the create subcommand that creates a maintenance period
 var MaintenanceCreateCmd = &cobra.Command{
 	Use:   "create",
-	Short: "Create a maintenance period for all host groups",
-	Long:  `Create a one-time maintenance period for all host groups, active from now for the specified duration.`,
-	Args:  cobra.ExactArgs(0),
+	Short: "Create a maintenance period for hosts and host groups",
+	Long: `Create a maintenance period, one-time or recurring.
+
+By default the maintenance is one-time, active from --start (now) for --duration (Go durations
+such as '90m' or '1h30m'; a plain number is a number of hours). --end can be given instead of
+--duration.
+
+Recurring maintenances are described with --every day|week|month|year and --at (start time of
+each occurrence); --duration is then the length of each occurrence and --end the end of the
+active window (default: one year after --start). The next occurrences are printed before the
+maintenance is created; use --dry-run to only print them.
+
+The maintenance covers the hosts given with --host and the host groups given with --group
+(names or globs such as 'web*'). --tag restricts the maintenance to problems with the given tags.
+A target is mandatory: use --all-groups to explicitly cover every host group.
+
+Examples:
+  zabbix-cli maintenance create --host web01 --host 'db*' -D 2
+  zabbix-cli maintenance create --group 'Linux servers' --tag service=nginx --tag env=prod --tags-eval or
+  zabbix-cli maintenance create --all-groups --start "2026-10-20 22:00" --duration 90m
+  zabbix-cli maintenance create --host db01 --every week --days mon,thu --at 02:00 --duration 1h
+  zabbix-cli maintenance create --group DB --every month --days sun --week-of-month 5 --at 01:00 -D 3h
+  zabbix-cli maintenance create --group DB --every month --day 1,15 --at 23:00 -D 2h --end 2027-06-30`,
+	Args: cobra.ExactArgs(0),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := context.Background()
 
-		// Validate configuration
-		if conf == nil {
-			return fmt.Errorf("configuration not initialized; initConfig did not run or failed")
+		if len(maintenanceHosts) == 0 && len(maintenanceGroups) == 0 && !maintenanceAllGroups {
+			return errNoMaintenanceTarget
 		}
-
-		// Initialize Zabbix client
-		z := zabbix.New(conf.ZabbixUser, conf.ZabbixPassword, conf.ZabbixEndpoint)
-		if err := z.Login(ctx); err != nil {
-			return fmt.Errorf("login failed: %w", err)
+		if maintenanceAllGroups && len(maintenanceGroups) > 0 {
+			return fmt.Errorf("--all-groups and --group are mutually exclusive")
 		}
-		defer func() {
-			if err := z.Logout(ctx); err != nil {
-				fmt.Fprintf(os.Stderr, "logout failed: %v\n", err)
-			}
-		}()
-
-		// Get all host groups
-		hgRequest := zabbix.NewGetAllHostGroupsRequest(
-			zabbix.WithHostGroupGetAuth(z.Auth()),
-			zabbix.WithHostGroupGetID(1),
-		)
-
-		response, err := z.HostGroupGet(ctx, hgRequest)
+		tags, err := parseTags(maintenanceTags)
 		if err != nil {
-			return fmt.Errorf("failed to get host groups: %w", err)
+			return err
 		}
-
-		if len(response.Result) == 0 {
-			return fmt.Errorf("no host groups found")
+		tagsEvalType, err := parseTagsEvalType(maintenanceTagsEval)
+		if err != nil {
+			return err
 		}
-
-		// Extract host group IDs
-		groupIDs := make([]string, 0, len(response.Result))
-		for _, hg := range response.Result {
-			groupIDs = append(groupIDs, hg.GroupID)
+		now := time.Now()
+		window, err := maintenanceSched.window(cmd.Flags(), now)
+		if err != nil {
+			return err
+		}
+		window.printOccurrences(cmd.OutOrStdout(), now, maintenanceSched.occurrences)
+		if maintenanceDryRun {
+			return nil
 		}
 
-		// Calculate maintenance period
-		now := time.Now()
-		activeTill := now.Add(time.Duration(maintenanceDuration) * time.Hour).Unix()
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
 
-		// Create a one-time maintenance period
-		timePeriod := []zabbix.TimePeriod{
-			{
-				TimePeriodType: zabbix.TimePeriodTypeOneTime,
-				StartTime:      0,                          // Start at the beginning of active_since
-				Period:         maintenanceDuration * 3600, // Duration in seconds
-			},
+		target, err := resolveMaintenanceTarget(ctx, z, maintenanceHosts, maintenanceGroups, maintenanceAllGroups)
+		if err != nil {
+			return err
 		}
 
 		if maintenanceName == "" {
@@ -94,18 +115,26 @@ var MaintenanceCreateCmd = &cobra.Command{
 			maintenanceType = zabbix.MaintenanceWithDataCollection
 		}
 		// Prepare maintenance creation request
-		maintenanceRequest := zabbix.NewMaintenanceCreateRequest(
+		options := []zabbix.MaintenanceCreateOption{
 			zabbix.WithMaintenanceName(maintenanceName),
 			zabbix.WithMaintenanceDescription(maintenanceDescription),
-			zabbix.WithMaintenanceActiveTill(activeTill),
+			zabbix.WithMaintenanceActiveSince(window.activeSince.Unix()),
+			zabbix.WithMaintenanceActiveTill(window.activeTill.Unix()),
 			zabbix.WithMaintenanceType(maintenanceType),
-			zabbix.WithMaintenanceTimePeriods(timePeriod),
-			zabbix.WithMaintenanceGroupIDs(groupIDs),
+			zabbix.WithMaintenanceTimePeriods(window.periods),
+			zabbix.WithMaintenanceGroupIDs(target.groupIDs),
+			zabbix.WithMaintenanceHostIDs(target.hostIDs),
 			zabbix.WithMaintenanceAuthToken(z.Auth()),
 			zabbix.WithMaintenanceRequestID(1),
-		)
+		}
+		if len(tags) > 0 {
+			options = append(options,
+				zabbix.WithMaintenanceTags(tags),
+				zabbix.WithMaintenanceTagsEvalType(tagsEvalType),
+			)
+		}
 		// Create maintenance
-		maintenanceResponse, err := z.MaintenanceCreate(ctx, maintenanceRequest)
+		maintenanceResponse, err := z.MaintenanceCreate(ctx, zabbix.NewMaintenanceCreateRequest(options...))
 		if err != nil {
 			return fmt.Errorf("failed to create maintenance: %w", err)
 		}
@@ -113,10 +142,56 @@ var MaintenanceCreateCmd = &cobra.Command{
 		// Display result
 		fmt.Fprintf(cmd.OutOrStdout(), "Maintenance created successfully.\n")
 		fmt.Fprintf(cmd.OutOrStdout(), "Maintenance IDs: %v\n", maintenanceResponse.Result.MaintenanceIDs)
-		fmt.Fprintf(cmd.OutOrStdout(), "Applied to %d host groups\n", len(groupIDs))
-		fmt.Fprintf(cmd.OutOrStdout(), "Active from: %s\n", now.Format(time.RFC3339))
-		fmt.Fprintf(cmd.OutOrStdout(), "Active until: %s\n", now.Add(time.Duration(maintenanceDuration)*time.Hour).Format(time.RFC3339))
+		fmt.Fprintf(cmd.OutOrStdout(), "Applied to %d host groups and %d hosts\n", len(target.groupIDs), len(target.hostIDs))
 
 		return nil
 	},
 }
+
+// maintenanceTarget holds the resolved hosts and host groups of a maintenance.
+type maintenanceTarget struct {
+	hostIDs  []string
+	groupIDs []string
+}
+
+// resolveMaintenanceTarget resolves host and host group names or globs to IDs.
+// With allGroups, every host group is included.
+func resolveMaintenanceTarget(ctx context.Context, z *zabbix.Client, hosts, groups []string, allGroups bool) (*maintenanceTarget, error) {
+	target := &maintenanceTarget{}
+	var err error
+	if len(hosts) > 0 {
+		if target.hostIDs, err = resolveHostIDs(ctx, z, hosts); err != nil {
+			return nil, err
+		}
+	}
+	if len(groups) > 0 {
+		if target.groupIDs, err = resolveHostGroupIDs(ctx, z, groups); err != nil {
+			return nil, err
+		}
+	}
+	if allGroups {
+		if target.groupIDs, err = allHostGroupIDs(ctx, z); err != nil {
+			return nil, err
+		}
+	}
+	return target, nil
+}
+
+// allHostGroupIDs returns the IDs of every host group.
+func allHostGroupIDs(ctx context.Context, z *zabbix.Client) ([]string, error) {
+	response, err := z.HostGroupGet(ctx, zabbix.NewGetAllHostGroupsRequest(
+		zabbix.WithHostGroupGetAuth(z.Auth()),
+		zabbix.WithHostGroupGetID(1),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host groups: %w", err)
+	}
+	if len(response.Result) == 0 {
+		return nil, fmt.Errorf("no host groups found")
+	}
+	groupIDs := make([]string, 0, len(response.Result))
+	for _, hg := range response.Result {
+		groupIDs = append(groupIDs, hg.GroupID)
+	}
+	return groupIDs, nil
+}
@@ -3,21 +3,144 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
+	"io"
+	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
 	"github.com/spf13/cobra"
 )
 
+// createdByCLIMarker is part of the description of every maintenance created by zabbix-cli.
+const createdByCLIMarker = "created by zabbix-cli"
+
+var (
+	maintenanceDeleteNameGlob     string
+	maintenanceDeleteExpired      bool
+	maintenanceDeleteCreatedByCLI bool
+	maintenanceDeleteOlderThan    string
+	maintenanceDeleteYes          bool
+	maintenanceDeleteDryRun       bool
+)
+
+// maintenanceSelector selects maintenances by ID or name and by criteria. All the criteria must match.
+type maintenanceSelector struct {
+	idsOrNames   []string
+	nameGlob     string
+	expired      bool
+	createdByCLI bool
+	olderThan    time.Duration
+}
+
+// empty returns true if the selector does not select anything.
+func (s *maintenanceSelector) empty() bool {
+	return len(s.idsOrNames) == 0 && s.nameGlob == "" && !s.expired && !s.createdByCLI && s.olderThan == 0
+}
+
+// selectFrom returns the maintenances matching the selector, and the IDs or names that match no maintenance.
+func (s *maintenanceSelector) selectFrom(maintenances []zabbix.Maintenance, now time.Time) ([]zabbix.Maintenance, []string) {
+	var missing []string
+	wanted := make(map[string]bool, len(s.idsOrNames))
+	for _, idOrName := range s.idsOrNames {
+		found := false
+		for _, m := range maintenances {
+			if m.MaintenanceID == idOrName || m.Name == idOrName {
+				wanted[m.MaintenanceID] = true
+				found = true
+			}
+		}
+		if !found {
+			missing = append(missing, idOrName)
+		}
+	}
+
+	var selected []zabbix.Maintenance
+	for _, m := range maintenances {
+		if len(s.idsOrNames) > 0 && !wanted[m.MaintenanceID] {
+			continue
+		}
+		if s.nameGlob != "" && !globMatch(s.nameGlob, m.Name) {
+			continue
+		}
+		if s.expired && m.ActiveTill.Int64() >= now.Unix() {
+			continue
+		}
+		if s.createdByCLI && !strings.Contains(strings.ToLower(m.Description), createdByCLIMarker) {
+			continue
+		}
+		if s.olderThan > 0 && !maintenanceCreatedAt(&m).Before(now.Add(-s.olderThan)) {
+			continue
+		}
+		selected = append(selected, m)
+	}
+	return selected, missing
+}
+
+// maintenanceCreatedAt returns the creation time of a maintenance, or its start when
+// the server does not report the creation time.
+func maintenanceCreatedAt(m *zabbix.Maintenance) time.Time {
+	if m.CreatedAt.Int64() > 0 {
+		return time.Unix(m.CreatedAt.Int64(), 0)
+	}
+	return time.Unix(m.ActiveSince.Int64(), 0)
+}
+
 // MaintenanceDeleteCmd represents the delete maintenance subcommand
 var MaintenanceDeleteCmd = &cobra.Command{
-	Use:   "delete",
+	Use:   "delete [id|name...]",
 	Short: "Delete maintenance periods",
-	Long:  `Delete maintenance periods from your Zabbix installation.`,
-	Run: func(cmd *cobra.Command, _ []string) {
-		// Print help if no subcommands are provided
-		cmd.Help() //nolint:errcheck
+	Long: `Delete maintenance periods selected by ID or name and/or by criteria.
+When several criteria are given, a maintenance must match all of them.
+
+The selected maintenances are listed and a confirmation is asked unless --yes is given.
+Use --dry-run to only list them.
+
+--created-by-cli relies on the default description written by zabbix-cli: it selects the
+maintenances whose description contains "created by zabbix-cli". Maintenances created with a
+custom --description are not selected, and maintenances created by hand whose description
+contains this text are.
+
+Examples:
+  zabbix-cli maintenance delete 12 "Deploy web"
+  zabbix-cli maintenance delete --name-glob 'Silence event *' --expired
+  zabbix-cli maintenance delete --created-by-cli --older-than 7d --yes
+  zabbix-cli maintenance delete all --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		selector := maintenanceSelector{
+			idsOrNames:   args,
+			nameGlob:     maintenanceDeleteNameGlob,
+			expired:      maintenanceDeleteExpired,
+			createdByCLI: maintenanceDeleteCreatedByCLI,
+		}
+		if maintenanceDeleteOlderThan != "" {
+			d, err := parseDuration(maintenanceDeleteOlderThan)
+			if err != nil {
+				return err
+			}
+			selector.olderThan = d
+		}
+		if selector.empty() {
+			return fmt.Errorf("no maintenance selected: give IDs or names, or use --name-glob, --expired, --created-by-cli or --older-than ('delete all' deletes everything)")
+		}
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		maintenances, err := getAllMaintenances(ctx, z)
+		if err != nil {
+			return err
+		}
+		selected, missing := selector.selectFrom(maintenances, time.Now())
+		if len(missing) > 0 {
+			return fmt.Errorf("maintenance(s) %s: %w", strings.Join(missing, ", "), errNotFound)
+		}
+		return deleteMaintenances(ctx, cmd, z, selected)
 	},
 }
 
@@ -25,58 +148,89 @@ var MaintenanceDeleteCmd = &cobra.Command{
 var MaintenanceDeleteAllCmd = &cobra.Command{
 	Use:   "all",
 	Short: "Delete all maintenance periods",
-	Long:  `Delete all maintenance periods from your Zabbix installation.`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		var err error
+	Long: `Delete all maintenance periods from your Zabbix installation.
+The maintenances are listed and a confirmation is asked unless --yes is given.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
 		ctx := context.Background()
 
-		err = initConfig()
+		z, logout, err := newClient(ctx)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v\n", err.Error())
-			os.Exit(1)
+			return err
 		}
+		defer logout()
 
-		z := zabbix.New(conf.ZabbixUser, conf.ZabbixPassword, conf.ZabbixEndpoint)
-		err = z.Login(ctx)
+		maintenances, err := getAllMaintenances(ctx, z)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v\n", err.Error())
-			os.Exit(1)
+			return err
 		}
-		defer z.Logout(ctx) //nolint:errcheck
+		return deleteMaintenances(ctx, cmd, z, maintenances)
+	},
+}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
+func init() {
+	MaintenanceDeleteCmd.Flags().StringVar(&maintenanceDeleteNameGlob, "name-glob", "", "Select maintenances whose name matches the pattern ('*' wildcard, case-insensitive)")
+	MaintenanceDeleteCmd.Flags().BoolVar(&maintenanceDeleteExpired, "expired", false, "Select maintenances whose active window has ended")
+	MaintenanceDeleteCmd.Flags().BoolVar(&maintenanceDeleteCreatedByCLI, "created-by-cli", false, "Select maintenances whose description contains '"+createdByCLIMarker+"' (the default description of the maintenances created by zabbix-cli)")
+	MaintenanceDeleteCmd.Flags().StringVar(&maintenanceDeleteOlderThan, "older-than", "", "Select maintenances created (or started) more than this long ago (e.g., '7d', '12h')")
+	MaintenanceDeleteCmd.PersistentFlags().BoolVarP(&maintenanceDeleteYes, "yes", "y", false, "Do not ask for confirmation")
+	MaintenanceDeleteCmd.PersistentFlags().BoolVar(&maintenanceDeleteDryRun, "dry-run", false, "List the maintenances that would be deleted without deleting them")
+}
 
-		// First, get all maintenance periods
-		maintenanceRequest := zabbix.NewMaintenanceGetRequest(zabbix.WithMaintenanceGetAuthToken(z.Auth()))
-		response, err := z.MaintenanceGet(ctx, maintenanceRequest)
-		if err != nil {
-			return fmt.Errorf("failed to get maintenance periods: %w", err)
-		}
+// getAllMaintenances returns every maintenance.
+func getAllMaintenances(ctx context.Context, z *zabbix.Client) ([]zabbix.Maintenance, error) {
+	response, err := z.MaintenanceGet(ctx, zabbix.NewMaintenanceGetRequest(
+		zabbix.WithMaintenanceGetOutput("extend"),
+		zabbix.WithMaintenanceGetAuthToken(z.Auth()),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance periods: %w", err)
+	}
+	return response.Result, nil
+}
 
-		if len(response.Result) == 0 {
-			fmt.Println("No maintenance periods found to delete")
-			return nil
-		}
+// deleteMaintenances lists the maintenances, asks for confirmation and deletes them,
+// honouring --dry-run and --yes.
+func deleteMaintenances(ctx context.Context, cmd *cobra.Command, z *zabbix.Client, maintenances []zabbix.Maintenance) error {
+	out := cmd.OutOrStdout()
+	if len(maintenances) == 0 {
+		fmt.Fprintln(out, "No maintenance periods found to delete")
+		return nil
+	}
 
-		// Extract maintenance IDs for deletion
-		maintenanceIDs := make([]string, 0, len(response.Result))
-		for _, maintenance := range response.Result {
-			maintenanceIDs = append(maintenanceIDs, maintenance.MaintenanceID)
-		}
+	printMaintenancePreview(out, maintenances)
+	if maintenanceDeleteDryRun {
+		fmt.Fprintf(out, "Dry run: %d maintenance period(s) would be deleted\n", len(maintenances))
+		return nil
+	}
+	if !maintenanceDeleteYes && !confirm(cmd.InOrStdin(), out, fmt.Sprintf("Delete %d maintenance period(s)?", len(maintenances))) {
+		return fmt.Errorf("aborted by user")
+	}
 
-		// Delete all maintenance periods
-		deleteRequest := zabbix.NewMaintenanceDeleteRequest(
-			zabbix.WithMaintenanceDeleteIDs(maintenanceIDs),
-			zabbix.WithMaintenanceDeleteAuthToken(z.Auth()),
-		)
+	ids := make([]string, 0, len(maintenances))
+	for _, m := range maintenances {
+		ids = append(ids, m.MaintenanceID)
+	}
+	response, err := z.MaintenanceDelete(ctx, zabbix.NewMaintenanceDeleteRequest(
+		zabbix.WithMaintenanceDeleteIDs(ids),
+		zabbix.WithMaintenanceDeleteAuthToken(z.Auth()),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to delete maintenance periods: %w", err)
+	}
 
-		deleteResponse, err := z.MaintenanceDelete(ctx, deleteRequest)
-		if err != nil {
-			return fmt.Errorf("failed to delete maintenance periods: %w", err)
-		}
+	fmt.Fprintf(out, "Successfully deleted %d maintenance periods\n", len(response.Result.MaintenanceIDs))
+	return nil
+}
 
-		fmt.Printf("Successfully deleted %d maintenance periods\n", len(deleteResponse.Result.MaintenanceIDs))
-		return nil
-	},
+// printMaintenancePreview prints the maintenances about to be deleted.
+func printMaintenancePreview(out io.Writer, maintenances []zabbix.Maintenance) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tACTIVE SINCE\tACTIVE TILL\tDESCRIPTION")
+	for _, m := range maintenances {
+		description, _, _ := strings.Cut(m.Description, "\n")
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", m.MaintenanceID, m.Name,
+			zabbix.FormatTimestamp(m.ActiveSince.Int64()), zabbix.FormatTimestamp(m.ActiveTill.Int64()), description)
+	}
+	w.Flush()
 }
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceSelector(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	day := int64(24 * 3600)
+	maintenances := []zabbix.Maintenance{
+		{MaintenanceID: "1", Name: "Silence event 42: High CPU", Description: "Maintenance created by zabbix-cli on 2026-10-01",
+			ActiveSince: zabbix.StringInt64(now.Unix() - 17*day), ActiveTill: zabbix.StringInt64(now.Unix() - 16*day)},
+		{MaintenanceID: "2", Name: "Deploy web", Description: "Maintenance created by zabbix-cli on 2026-10-18",
+			ActiveSince: zabbix.StringInt64(now.Unix() - 3600), ActiveTill: zabbix.StringInt64(now.Unix() + 3600)},
+		{MaintenanceID: "3", Name: "Weekly backup", Description: "manual",
+			ActiveSince: zabbix.StringInt64(now.Unix() - 100*day), ActiveTill: zabbix.StringInt64(now.Unix() + 100*day)},
+	}
+
+	ids := func(selected []zabbix.Maintenance) []string {
+		out := []string{}
+		for _, m := range selected {
+			out = append(out, m.MaintenanceID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		selector maintenanceSelector
+		want     []string
+		missing  []string
+	}{
+		{"by id and name", maintenanceSelector{idsOrNames: []string{"1", "Weekly backup"}}, []string{"1", "3"}, nil},
+		{"unknown name", maintenanceSelector{idsOrNames: []string{"2", "nope"}}, []string{"2"}, []string{"nope"}},
+		{"name glob", maintenanceSelector{nameGlob: "silence event *"}, []string{"1"}, nil},
+		{"expired", maintenanceSelector{expired: true}, []string{"1"}, nil},
+		{"created by cli", maintenanceSelector{createdByCLI: true}, []string{"1", "2"}, nil},
+		{"older than", maintenanceSelector{olderThan: 7 * 24 * time.Hour}, []string{"1", "3"}, nil},
+		{"combined", maintenanceSelector{createdByCLI: true, olderThan: 7 * 24 * time.Hour}, []string{"1"}, nil},
+		{"ids filtered by criteria", maintenanceSelector{idsOrNames: []string{"2", "3"}, createdByCLI: true}, []string{"2"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			selected, missing := tt.selector.selectFrom(maintenances, now)
+			assert.Equal(t, tt.want, ids(selected))
+			assert.Equal(t, tt.missing, missing)
+		})
+	}
+
+	assert.True(t, (&maintenanceSelector{}).empty())
+	assert.False(t, (&maintenanceSelector{expired: true}).empty())
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+)
+
+// ordinals are the names of the weeks of the month in monthly-by-weekday periods.
+var ordinals = map[int]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", lastWeekOfMonth: "last"}
+
+// describeTimePeriods describes time periods in plain language, e.g. "every Mon,Thu at 02:00 for 1h30m".
+// Periods that only differ by their day of the week or of the month are described together, as
+// 'maintenance create' creates one period per day. One-time periods are given in the location loc.
+func describeTimePeriods(periods []zabbix.TimePeriod, loc *time.Location) []string {
+	type group struct {
+		tp   zabbix.TimePeriod
+		days []int
+	}
+	var groups []*group
+	byKey := make(map[zabbix.TimePeriod]*group)
+	for i := range periods {
+		tp := periods[i].Normalized()
+		key := tp
+		var day int
+		switch tp.TimePeriodType {
+		case zabbix.TimePeriodTypeWeekly, zabbix.TimePeriodTypeMonthlyByWeekday:
+			day, key.DayOfWeek = tp.DayOfWeek, 0
+		case zabbix.TimePeriodTypeMonthly:
+			day, key.Day = tp.Day, 0
+		}
+		g, ok := byKey[key]
+		if !ok || tp.TimePeriodType == zabbix.TimePeriodTypeOneTime || tp.TimePeriodType == zabbix.TimePeriodTypeYearly {
+			g = &group{tp: tp}
+			byKey[key] = g
+			groups = append(groups, g)
+		}
+		g.days = append(g.days, day)
+	}
+
+	descriptions := make([]string, 0, len(groups))
+	for _, g := range groups {
+		descriptions = append(descriptions, describeTimePeriod(&g.tp, g.days, loc))
+	}
+	return descriptions
+}
+
+// describeTimePeriod describes a normalized time period occurring on the given days of the week
+// (weekly and monthly-by-weekday periods) or of the month (monthly periods).
+func describeTimePeriod(tp *zabbix.TimePeriod, days []int, loc *time.Location) string {
+	duration := formatDuration(time.Duration(tp.Period) * time.Second)
+	at := fmt.Sprintf("at %02d:%02d for %s", tp.StartTime/3600, tp.StartTime%3600/60, duration)
+	switch tp.TimePeriodType {
+	case zabbix.TimePeriodTypeOneTime:
+		return fmt.Sprintf("once on %s for %s", time.Unix(tp.StartDate, 0).In(loc).Format("2006-01-02 15:04"), duration)
+	case zabbix.TimePeriodTypeDaily:
+		return fmt.Sprintf("every %s %s", plural(tp.Every, "day"), at)
+	case zabbix.TimePeriodTypeWeekly:
+		if tp.Every == 1 {
+			return fmt.Sprintf("every %s %s", weekdayList(days), at)
+		}
+		return fmt.Sprintf("every %s on %s %s", plural(tp.Every, "week"), weekdayList(days), at)
+	case zabbix.TimePeriodTypeMonthly:
+		sort.Ints(days)
+		list := make([]string, 0, len(days))
+		for _, d := range days {
+			list = append(list, strconv.Itoa(d))
+		}
+		return fmt.Sprintf("every %s on day %s %s", plural(tp.Every, "month"), strings.Join(list, ","), at)
+	case zabbix.TimePeriodTypeMonthlyByWeekday:
+		return fmt.Sprintf("every %s %s of the month %s", ordinals[tp.Every], weekdayList(days), at)
+	case zabbix.TimePeriodTypeYearly:
+		date := fmt.Sprintf("%s %d", time.Month(tp.Month).String()[:3], tp.Day)
+		if tp.Year > 0 {
+			return fmt.Sprintf("on %s %d %s", date, tp.Year, at)
+		}
+		return fmt.Sprintf("every year on %s %s", date, at)
+	default:
+		return fmt.Sprintf("unknown period type %d", tp.TimePeriodType)
+	}
+}
+
+// plural returns "unit" for 1 and "n units" otherwise, as in "every day" and "every 3 days".
+func plural(n int, unit string) string {
+	if n == 1 {
+		return unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+// weekdayList returns the abbreviated names of the days of the week, Monday first, e.g. "Mon,Thu".
+func weekdayList(days []int) string {
+	sorted := append([]int(nil), days...)
+	// Shift Sunday (0) after Saturday (6).
+	sort.Slice(sorted, func(i, j int) bool { return (sorted[i]+6)%7 < (sorted[j]+6)%7 })
+	names := make([]string, 0, len(sorted))
+	for _, d := range sorted {
+		names = append(names, time.Weekday(d).String()[:3])
+	}
+	return strings.Join(names, ",")
+}
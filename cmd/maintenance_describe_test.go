@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeTimePeriods(t *testing.T) {
+	t.Parallel()
+
+	weekly := func(day int) zabbix.TimePeriod {
+		return zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeWeekly, Every: 1, DayOfWeek: day, StartTime: 2 * 3600, Period: 5400}
+	}
+	tests := []struct {
+		name     string
+		periods  []zabbix.TimePeriod
+		expected []string
+	}{
+		{
+			name:     "weekly periods merged",
+			periods:  []zabbix.TimePeriod{weekly(4), weekly(1)},
+			expected: []string{"every Mon,Thu at 02:00 for 1h30m"},
+		},
+		{
+			name: "sunday last",
+			periods: []zabbix.TimePeriod{weekly(0), weekly(6),
+				{TimePeriodType: zabbix.TimePeriodTypeWeekly, Every: 2, DayOfWeek: 3, StartTime: 2 * 3600, Period: 5400}},
+			expected: []string{"every Sat,Sun at 02:00 for 1h30m", "every 2 weeks on Wed at 02:00 for 1h30m"},
+		},
+		{
+			name: "one-time",
+			periods: []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeOneTime,
+				StartDate: time.Date(2026, 10, 20, 22, 0, 0, 0, time.UTC).Unix(), Period: 7200}},
+			expected: []string{"once on 2026-10-20 22:00 for 2h"},
+		},
+		{
+			name: "daily",
+			periods: []zabbix.TimePeriod{
+				{TimePeriodType: zabbix.TimePeriodTypeDaily, Every: 1, StartTime: 23*3600 + 30*60, Period: 1800},
+				{TimePeriodType: zabbix.TimePeriodTypeDaily, Every: 3, StartTime: 0, Period: 3600},
+			},
+			expected: []string{"every day at 23:30 for 30m", "every 3 days at 00:00 for 1h"},
+		},
+		{
+			name: "monthly days merged",
+			periods: []zabbix.TimePeriod{
+				{TimePeriodType: zabbix.TimePeriodTypeMonthly, Every: 1, Day: 15, StartTime: 3600, Period: 3600},
+				{TimePeriodType: zabbix.TimePeriodTypeMonthly, Every: 1, Day: 1, StartTime: 3600, Period: 3600},
+			},
+			expected: []string{"every month on day 1,15 at 01:00 for 1h"},
+		},
+		{
+			name: "monthly by weekday",
+			periods: []zabbix.TimePeriod{
+				{TimePeriodType: zabbix.TimePeriodTypeMonthlyByWeekday, Every: lastWeekOfMonth, DayOfWeek: 0, StartTime: 4 * 3600, Period: 4 * 3600},
+				{TimePeriodType: zabbix.TimePeriodTypeMonthlyByWeekday, Every: 2, DayOfWeek: 2, StartTime: 4 * 3600, Period: 4 * 3600},
+			},
+			expected: []string{"every last Sun of the month at 04:00 for 4h", "every 2nd Tue of the month at 04:00 for 4h"},
+		},
+		{
+			name: "yearly",
+			periods: []zabbix.TimePeriod{
+				{TimePeriodType: zabbix.TimePeriodTypeYearly, Month: 12, Day: 24, StartTime: 18 * 3600, Period: 2 * 86400},
+				{TimePeriodType: zabbix.TimePeriodTypeYearly, Month: 12, Day: 24, Year: 2026, StartTime: 18 * 3600, Period: 2 * 86400},
+			},
+			expected: []string{"every year on Dec 24 at 18:00 for 2d", "on Dec 24 2026 at 18:00 for 2d"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, describeTimePeriods(tt.periods, time.UTC))
+		})
+	}
+}
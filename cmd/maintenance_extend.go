@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var maintenanceExtendBy string
+
+// MaintenanceExtendCmd represents the maintenance extend subcommand
+var MaintenanceExtendCmd = &cobra.Command{
+	Use:   "extend <id|name>",
+	Short: "Extend a maintenance period",
+	Long: `Push out the end of a maintenance (active_till) and the length of its last one-time period.
+Every other field of the maintenance is preserved.
+
+Examples:
+  zabbix-cli maintenance extend "Deploy web" --by 1h
+  zabbix-cli maintenance extend 12 --by 30m`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		by, err := parseDuration(maintenanceExtendBy)
+		if err != nil {
+			return err
+		}
+		if by <= 0 {
+			return fmt.Errorf("--by must be a positive duration")
+		}
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		current, err := getMaintenance(ctx, z, args[0])
+		if err != nil {
+			return err
+		}
+		maintenance := current.UpdateParams()
+		maintenance.Extend(by)
+
+		if err := updateMaintenance(ctx, z, maintenance); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Maintenance %s (%s) extended by %s.\n", maintenance.MaintenanceID, maintenance.Name, by)
+		fmt.Fprintf(cmd.OutOrStdout(), "Active until: %s (was %s)\n",
+			time.Unix(maintenance.ActiveTill.Int64(), 0).Format(time.RFC3339),
+			time.Unix(current.ActiveTill.Int64(), 0).Format(time.RFC3339))
+		return nil
+	},
+}
+
+func init() {
+	MaintenanceExtendCmd.Flags().StringVar(&maintenanceExtendBy, "by", "1h", "Duration to add to the maintenance (e.g., '30m', '1h', '1d')")
+}
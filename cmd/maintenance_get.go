@@ -4,56 +4,87 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
+	"io"
+	"slices"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
 	"github.com/spf13/cobra"
 )
 
+// maintenanceGetFormats are the values accepted by 'maintenance get --output'.
+var maintenanceGetFormats = []string{"table", "wide", "json", "yaml", "ics"}
+
+// States of a maintenance, relative to its active window.
+const (
+	maintenanceStateActive   = "active"
+	maintenanceStateUpcoming = "upcoming"
+	maintenanceStateExpired  = "expired"
+)
+
 var (
 	maintenanceGetOutput    string
 	maintenanceGetFormat    string
+	maintenanceGetFields    string
 	maintenanceGetGroupIDs  string
 	maintenanceGetHostIDs   string
 	maintenanceGetIDs       string
 	maintenanceGetLimit     int
 	maintenanceGetSortField string
+	maintenanceGetName      string
+	maintenanceGetHosts     []string
+	maintenanceGetGroups    []string
+	maintenanceGetState     string
 )
 
 // MaintenanceGetCmd represents the maintenance get command
 var MaintenanceGetCmd = &cobra.Command{
 	Use:   "get",
 	Short: "Get maintenance periods",
-	Long:  `Get maintenance periods from Zabbix with optional filtering.`,
+	Long: `Get maintenance periods from Zabbix with optional filtering.
+
+--name filters by name ('*' wildcard). --host and --group take names or globs: --host keeps
+the maintenances covering one of the hosts, directly or through its host groups, and --group
+the maintenances covering one of the host groups. --state keeps the maintenances whose active
+window is in progress (active), not started yet (upcoming) or over (expired).
+
+--output selects the format: table (default), wide (with host groups, hosts and tags), json,
+yaml or ics. Tables describe the time periods in plain language. The YAML output can be edited
+and given back to 'zabbix-cli maintenance apply -f'. The iCalendar (ics) output has one event
+per time period, recurring until the end of the active window, and can be published for
+calendar applications to subscribe to.
+
+Examples:
+  zabbix-cli maintenance get
+  zabbix-cli maintenance get --name 'Patch*' --state active -o wide
+  zabbix-cli maintenance get --host web01
+  zabbix-cli maintenance get -o yaml > windows.yaml
+  zabbix-cli maintenance get -o ics > maintenances.ics`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if conf == nil {
-			return fmt.Errorf("zabbix-cli is not configured properly")
+		format, err := maintenanceGetOutputFormat(cmd)
+		if err != nil {
+			return err
+		}
+		switch maintenanceGetState {
+		case "", maintenanceStateActive, maintenanceStateUpcoming, maintenanceStateExpired:
+		default:
+			return fmt.Errorf("invalid --state %q (expected 'active', 'upcoming' or 'expired')", maintenanceGetState)
 		}
 
 		ctx := context.Background()
 
-		// Initialize the Zabbix client
-		z := zabbix.New(conf.ZabbixUser, conf.ZabbixPassword, conf.ZabbixEndpoint)
-		if err := z.Login(ctx); err != nil {
-			return fmt.Errorf("login failed: %w", err)
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
 		}
-		defer func() {
-			if err := z.Logout(ctx); err != nil {
-				fmt.Fprintf(os.Stderr, "logout failed: %v\n", err)
-			}
-		}()
+		defer logout()
 
 		// Prepare options for the maintenance.get request
-		options := []zabbix.MaintenanceGetOption{
-			zabbix.WithMaintenanceGetAuthToken(z.Auth()),
-			zabbix.WithMaintenanceGetID(1), // Simple ID for CLI context
-		}
-
-		// Parse output parameter
-		if maintenanceGetOutput != "" {
-			options = append(options, zabbix.WithMaintenanceGetOutput(maintenanceGetOutput))
+		options := append(maintenanceDetailOptions(z), zabbix.WithMaintenanceGetID(1))
+		if maintenanceGetFields != "" && format != "yaml" && format != "ics" {
+			options = append(options, zabbix.WithMaintenanceGetOutput(maintenanceGetFields))
 		}
 
 		// Parse group IDs
@@ -74,8 +105,16 @@ var MaintenanceGetCmd = &cobra.Command{
 			options = append(options, zabbix.WithMaintenanceGetMaintenanceIDs(maintenanceIDs))
 		}
 
-		// Set limit if provided
-		if maintenanceGetLimit > 0 {
+		// Search by name
+		if maintenanceGetName != "" {
+			options = append(options,
+				zabbix.WithMaintenanceGetSearch(map[string]any{"name": maintenanceGetName}),
+				zabbix.WithMaintenanceGetSearchWildcardsEnabled(true))
+		}
+
+		// Set limit if provided; with client-side filters, it is applied after filtering.
+		filter := maintenanceFilter{state: maintenanceGetState, now: time.Now(), limit: maintenanceGetLimit}
+		if maintenanceGetLimit > 0 && maintenanceGetState == "" && len(maintenanceGetHosts) == 0 && len(maintenanceGetGroups) == 0 {
 			options = append(options, zabbix.WithMaintenanceGetLimit(maintenanceGetLimit))
 		}
 
@@ -92,56 +131,164 @@ var MaintenanceGetCmd = &cobra.Command{
 			return fmt.Errorf("failed to get maintenance periods: %w", err)
 		}
 
+		if len(maintenanceGetHosts) > 0 {
+			if filter.hosts, err = resolveHostsWithGroups(ctx, z, maintenanceGetHosts); err != nil {
+				return err
+			}
+		}
+		if len(maintenanceGetGroups) > 0 {
+			if filter.groups, err = resolveHostGroups(ctx, z, maintenanceGetGroups); err != nil {
+				return err
+			}
+		}
+		maintenances := filter.apply(response.Result)
+
 		// Output the results
-		if maintenanceGetFormat == "json" {
-			// Output in JSON format
-			jsonBytes, err := json.MarshalIndent(response.Result, "", "  ")
+		out := cmd.OutOrStdout()
+		switch format {
+		case "yaml":
+			return writeMaintenanceSpecs(out, maintenances)
+		case "ics":
+			return writeMaintenanceICS(out, maintenances, time.Now(), icsLocation())
+		case "json":
+			jsonBytes, err := json.MarshalIndent(maintenances, "", "  ")
 			if err != nil {
 				return fmt.Errorf("failed to marshal result to JSON: %w", err)
 			}
-			fmt.Println(string(jsonBytes))
-		} else {
-			// Output in table format
-			fmt.Println("Maintenance Periods:")
-			fmt.Println("--------------------")
-
-			for _, m := range response.Result {
-				maintenanceType := "With Data Collection"
-				if m.MaintenanceType == zabbix.MaintenanceNoDataCollection {
-					maintenanceType = "No Data Collection"
-				}
-
-				// Format timestamps as human-readable dates
-				activeSince := time.Unix(m.ActiveSince.Int64(), 0).Format("2006-01-02 15:04:05")
-				activeTill := time.Unix(m.ActiveTill.Int64(), 0).Format("2006-01-02 15:04:05")
-
-				fmt.Printf("ID: %s\n", m.MaintenanceID)
-				fmt.Printf("Name: %s\n", m.Name)
-				if m.Description != "" {
-					fmt.Printf("Description: %s\n", m.Description)
-				}
-				fmt.Printf("Active Since: %s\n", activeSince)
-				fmt.Printf("Active Till: %s\n", activeTill)
-				fmt.Printf("Type: %s\n", maintenanceType)
-				fmt.Printf("Host Groups: %d\n", len(m.GroupIDs))
-				fmt.Printf("Hosts: %d\n", len(m.HostIDs))
-				fmt.Println("--------------------")
-			}
-
-			fmt.Printf("Total maintenance periods: %d\n", len(response.Result))
+			fmt.Fprintln(out, string(jsonBytes))
+		default:
+			printMaintenanceTable(out, maintenances, format == "wide", time.Now())
 		}
-
 		return nil
 	},
 }
 
 func init() {
 	// Add flags for maintenance get command
-	MaintenanceGetCmd.Flags().StringVarP(&maintenanceGetOutput, "output", "o", "", "Fields to return (comma-separated)")
-	MaintenanceGetCmd.Flags().StringVarP(&maintenanceGetFormat, "format", "f", "table", "Output format: table or json")
+	MaintenanceGetCmd.Flags().StringVarP(&maintenanceGetOutput, "output", "o", "table", "Output format: "+strings.Join(maintenanceGetFormats, ", "))
+	MaintenanceGetCmd.Flags().StringVarP(&maintenanceGetFormat, "format", "f", "", "Output format: table or json")
+	_ = MaintenanceGetCmd.Flags().MarkDeprecated("format", "use --output instead")
+	MaintenanceGetCmd.Flags().StringVar(&maintenanceGetFields, "fields", "", "Fields to return (comma-separated)")
+	MaintenanceGetCmd.Flags().StringVar(&maintenanceGetName, "name", "", "Filter by name ('*' wildcard, e.g. 'Patch*')")
+	MaintenanceGetCmd.Flags().StringArrayVar(&maintenanceGetHosts, "host", nil, "Filter by covered host, by name or glob; repeatable")
+	MaintenanceGetCmd.Flags().StringArrayVar(&maintenanceGetGroups, "group", nil, "Filter by covered host group, by name or glob; repeatable")
+	MaintenanceGetCmd.Flags().StringVar(&maintenanceGetState, "state", "", "Filter by state: active, upcoming or expired")
 	MaintenanceGetCmd.Flags().StringVarP(&maintenanceGetGroupIDs, "groupids", "g", "", "Filter by host group IDs (comma-separated)")
 	MaintenanceGetCmd.Flags().StringVarP(&maintenanceGetHostIDs, "hostids", "H", "", "Filter by host IDs (comma-separated)")
 	MaintenanceGetCmd.Flags().StringVarP(&maintenanceGetIDs, "maintenanceids", "m", "", "Filter by maintenance IDs (comma-separated)")
 	MaintenanceGetCmd.Flags().IntVarP(&maintenanceGetLimit, "limit", "l", 0, "Limit the number of results")
 	MaintenanceGetCmd.Flags().StringVarP(&maintenanceGetSortField, "sort", "s", "", "Sort field(s) (comma-separated)")
 }
+
+// maintenanceGetOutputFormat returns the output format of 'maintenance get'. For compatibility,
+// --format is still honoured and an --output value that is not a format is a list of fields,
+// as in previous versions.
+func maintenanceGetOutputFormat(cmd *cobra.Command) (string, error) {
+	format := strings.ToLower(maintenanceGetOutput)
+	legacyFields := !slices.Contains(maintenanceGetFormats, format)
+	if legacyFields {
+		if cmd.Flags().Changed("fields") {
+			return "", fmt.Errorf("invalid --output %q (expected one of: %s)", maintenanceGetOutput, strings.Join(maintenanceGetFormats, ", "))
+		}
+		fmt.Fprintln(cmd.ErrOrStderr(), "Warning: giving fields with --output is deprecated, use --fields instead")
+		maintenanceGetFields = maintenanceGetOutput
+		format = "table"
+	}
+	if maintenanceGetFormat != "" && (legacyFields || !cmd.Flags().Changed("output")) {
+		format = strings.ToLower(maintenanceGetFormat)
+		if !slices.Contains(maintenanceGetFormats, format) {
+			return "", fmt.Errorf("invalid --format %q (expected one of: %s)", maintenanceGetFormat, strings.Join(maintenanceGetFormats, ", "))
+		}
+	}
+	return format, nil
+}
+
+// maintenanceFilter filters maintenances on the client side. Empty criteria match everything.
+type maintenanceFilter struct {
+	hosts  []zabbix.Host      // with their host groups
+	groups []zabbix.HostGroup // covered directly
+	state  string
+	now    time.Time
+	limit  int // maximum number of maintenances returned, 0 for no limit
+}
+
+// apply returns the maintenances matching the filter, up to its limit.
+func (f *maintenanceFilter) apply(maintenances []zabbix.Maintenance) []zabbix.Maintenance {
+	selected := make([]zabbix.Maintenance, 0, len(maintenances))
+	for i := range maintenances {
+		m := &maintenances[i]
+		if f.state != "" && maintenanceState(m, f.now) != f.state {
+			continue
+		}
+		if len(f.hosts) > 0 && !slices.ContainsFunc(f.hosts, func(h zabbix.Host) bool { return maintenanceCoversHost(m, &h) }) {
+			continue
+		}
+		if len(f.groups) > 0 && !slices.ContainsFunc(f.groups, func(g zabbix.HostGroup) bool {
+			return slices.ContainsFunc(m.Groups, func(mg zabbix.HostGroup) bool { return mg.GroupID == g.GroupID })
+		}) {
+			continue
+		}
+		selected = append(selected, *m)
+		if f.limit > 0 && len(selected) == f.limit {
+			break
+		}
+	}
+	return selected
+}
+
+// maintenanceState returns the state of the active window of a maintenance at now.
+func maintenanceState(m *zabbix.Maintenance, now time.Time) string {
+	switch {
+	case m.ActiveTill.Int64() <= now.Unix():
+		return maintenanceStateExpired
+	case m.ActiveSince.Int64() > now.Unix():
+		return maintenanceStateUpcoming
+	default:
+		return maintenanceStateActive
+	}
+}
+
+// printMaintenanceTable prints the maintenances as a table. The wide table adds the host groups,
+// hosts and tags of each maintenance.
+func printMaintenanceTable(out io.Writer, maintenances []zabbix.Maintenance, wide bool, now time.Time) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	header := "ID\tNAME\tTYPE\tSTATE\tACTIVE SINCE\tACTIVE TILL\tPERIODS"
+	if wide {
+		header += "\tGROUPS\tHOSTS\tTAGS"
+	}
+	fmt.Fprintln(w, header)
+	for i := range maintenances {
+		m := &maintenances[i]
+		maintenanceType := "data"
+		if m.MaintenanceType == zabbix.MaintenanceNoDataCollection {
+			maintenanceType = "no data"
+		}
+		row := []string{
+			m.MaintenanceID, m.Name, maintenanceType, maintenanceState(m, now),
+			zabbix.FormatTimestamp(m.ActiveSince.Int64()), zabbix.FormatTimestamp(m.ActiveTill.Int64()),
+			strings.Join(describeTimePeriods(m.TimePeriods, time.Local), "; "),
+		}
+		if wide {
+			groups := make([]string, 0, len(m.Groups))
+			for _, g := range m.Groups {
+				groups = append(groups, g.Name)
+			}
+			hosts := make([]string, 0, len(m.Hosts))
+			for _, h := range m.Hosts {
+				hosts = append(hosts, h.Host)
+			}
+			tags := make([]string, 0, len(m.Tags))
+			for _, t := range m.Tags {
+				tags = append(tags, formatProblemTag(t))
+			}
+			tagsJoin := ","
+			if m.TagsEvalType == zabbix.TagsEvalTypeOr {
+				tagsJoin = "|"
+			}
+			row = append(row, strings.Join(groups, ","), strings.Join(hosts, ","), strings.Join(tags, tagsJoin))
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	w.Flush()
+	fmt.Fprintf(out, "Total maintenance periods: %d\n", len(maintenances))
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceFilter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	day := int64(86400)
+	maintenances := []zabbix.Maintenance{
+		{MaintenanceID: "1", Name: "running", ActiveSince: zabbix.StringInt64(now.Unix() - day), ActiveTill: zabbix.StringInt64(now.Unix() + day),
+			Hosts: []zabbix.Host{{HostID: "10084", Host: "web01"}}},
+		{MaintenanceID: "2", Name: "next week", ActiveSince: zabbix.StringInt64(now.Unix() + 7*day), ActiveTill: zabbix.StringInt64(now.Unix() + 8*day),
+			Groups: []zabbix.HostGroup{{GroupID: "2", Name: "Linux servers"}}},
+		{MaintenanceID: "3", Name: "last week", ActiveSince: zabbix.StringInt64(now.Unix() - 8*day), ActiveTill: zabbix.StringInt64(now.Unix() - 7*day),
+			Groups: []zabbix.HostGroup{{GroupID: "5", Name: "Windows"}}},
+	}
+	ids := func(ms []zabbix.Maintenance) []string {
+		var result []string
+		for _, m := range ms {
+			result = append(result, m.MaintenanceID)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		filter   maintenanceFilter
+		expected []string
+	}{
+		{"no filter", maintenanceFilter{now: now}, []string{"1", "2", "3"}},
+		{"active", maintenanceFilter{state: maintenanceStateActive, now: now}, []string{"1"}},
+		{"upcoming", maintenanceFilter{state: maintenanceStateUpcoming, now: now}, []string{"2"}},
+		{"expired", maintenanceFilter{state: maintenanceStateExpired, now: now}, []string{"3"}},
+		{"host directly or by group", maintenanceFilter{now: now,
+			hosts: []zabbix.Host{{HostID: "10084", HostGroups: []zabbix.HostGroup{{GroupID: "2"}}}}}, []string{"1", "2"}},
+		{"group", maintenanceFilter{now: now, groups: []zabbix.HostGroup{{GroupID: "5"}, {GroupID: "7"}}}, []string{"3"}},
+		{"group and state", maintenanceFilter{state: maintenanceStateActive, now: now, groups: []zabbix.HostGroup{{GroupID: "5"}}}, nil},
+		{"limit", maintenanceFilter{now: now, limit: 2}, []string{"1", "2"}},
+		{"limit after filter", maintenanceFilter{state: maintenanceStateExpired, now: now, limit: 1}, []string{"3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, ids(tt.filter.apply(maintenances)))
+		})
+	}
+}
+
+func TestPrintMaintenanceTable(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	maintenances := []zabbix.Maintenance{{
+		MaintenanceID: "7", Name: "Patching", MaintenanceType: zabbix.MaintenanceNoDataCollection,
+		ActiveSince: zabbix.StringInt64(now.Unix() - 3600), ActiveTill: zabbix.StringInt64(now.Unix() + 3600),
+		Groups:       []zabbix.HostGroup{{GroupID: "2", Name: "Linux servers"}},
+		Hosts:        []zabbix.Host{{HostID: "10084", Host: "web01"}, {HostID: "10085", Host: "web02"}},
+		Tags:         []zabbix.ProblemTag{{Tag: "service", Value: "nginx"}, {Tag: "env"}},
+		TagsEvalType: zabbix.TagsEvalTypeOr,
+		TimePeriods: []zabbix.TimePeriod{
+			{TimePeriodType: zabbix.TimePeriodTypeWeekly, Every: 1, DayOfWeek: 1, StartTime: 2 * 3600, Period: 5400},
+			{TimePeriodType: zabbix.TimePeriodTypeWeekly, Every: 1, DayOfWeek: 4, StartTime: 2 * 3600, Period: 5400},
+		},
+	}}
+
+	var out bytes.Buffer
+	printMaintenanceTable(&out, maintenances, false, now)
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3)
+	assert.Contains(t, string(lines[0]), "PERIODS")
+	assert.NotContains(t, string(lines[0]), "HOSTS")
+	assert.Contains(t, string(lines[1]), "7    Patching   no data   active")
+	assert.Contains(t, string(lines[1]), "every Mon,Thu at 02:00 for 1h30m")
+	assert.Equal(t, "Total maintenance periods: 1", string(lines[2]))
+
+	out.Reset()
+	printMaintenanceTable(&out, maintenances, true, now)
+	assert.Contains(t, out.String(), "GROUPS")
+	assert.Contains(t, out.String(), "Linux servers   web01,web02   service=nginx|env")
+}
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+)
+
+// getMaintenance returns the maintenance with the given ID or name, with its groups, hosts,
+// tags and time periods.
+func getMaintenance(ctx context.Context, z *zabbix.Client, idOrName string) (*zabbix.Maintenance, error) {
+	options := maintenanceDetailOptions(z)
+
+	if _, err := strconv.ParseUint(idOrName, 10, 64); err == nil {
+		response, err := z.MaintenanceGet(ctx, zabbix.NewMaintenanceGetRequest(
+			append(options, zabbix.WithMaintenanceGetMaintenanceIDs([]string{idOrName}))...))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get maintenance %s: %w", idOrName, err)
+		}
+		if len(response.Result) > 0 {
+			return &response.Result[0], nil
+		}
+	}
+
+	response, err := z.MaintenanceGet(ctx, zabbix.NewMaintenanceGetRequest(
+		append(options, zabbix.WithMaintenanceGetFilter(map[string]any{"name": idOrName}))...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance %s: %w", idOrName, err)
+	}
+	if len(response.Result) == 0 {
+		return nil, fmt.Errorf("maintenance %q: %w", idOrName, errNotFound)
+	}
+	return &response.Result[0], nil
+}
+
+// maintenanceDetailOptions returns the maintenance.get options selecting every field of the
+// maintenances with their groups, hosts, tags and time periods.
+func maintenanceDetailOptions(z *zabbix.Client) []zabbix.MaintenanceGetOption {
+	return []zabbix.MaintenanceGetOption{
+		zabbix.WithMaintenanceGetOutput("extend"),
+		zabbix.WithMaintenanceGetSelectGroups([]string{"groupid", "name"}),
+		zabbix.WithMaintenanceGetSelectHosts([]string{"hostid", "host", "name"}),
+		zabbix.WithMaintenanceGetSelectTags("extend"),
+		zabbix.WithMaintenanceGetSelectTimePeriods("extend"),
+		zabbix.WithMaintenanceGetAuthToken(z.Auth()),
+	}
+}
+
+// getMaintenancesWithDetails returns every maintenance with its groups, hosts, tags and time periods.
+func getMaintenancesWithDetails(ctx context.Context, z *zabbix.Client) ([]zabbix.Maintenance, error) {
+	response, err := z.MaintenanceGet(ctx, zabbix.NewMaintenanceGetRequest(maintenanceDetailOptions(z)...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance periods: %w", err)
+	}
+	return response.Result, nil
+}
+
+// getHostWithGroups returns the host with the given name, with its host groups.
+func getHostWithGroups(ctx context.Context, z *zabbix.Client, name string) (*zabbix.Host, error) {
+	hosts, err := resolveHostsWithGroups(ctx, z, []string{name})
+	if err != nil {
+		return nil, err
+	}
+	if len(hosts) > 1 {
+		return nil, fmt.Errorf("%q matches %d hosts, give a single host", name, len(hosts))
+	}
+	return &hosts[0], nil
+}
+
+// resolveHostsWithGroups returns the hosts matching the given names or globs, with their host groups.
+func resolveHostsWithGroups(ctx context.Context, z *zabbix.Client, patterns []string) ([]zabbix.Host, error) {
+	hosts, err := resolveHosts(ctx, z, patterns)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		ids = append(ids, h.HostID)
+	}
+	response, err := z.HostGet(ctx, zabbix.NewHostGetRequest(
+		zabbix.WithHostGetHostIDs(ids),
+		zabbix.WithHostGetOutput([]string{"hostid", "host", "name", "status"}),
+		zabbix.WithHostGetSelectHostGroups([]string{"groupid", "name"}),
+		zabbix.WithHostGetAuth(z.Auth()),
+		zabbix.WithHostGetID(1),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the host groups of %s: %w", strings.Join(patterns, ", "), err)
+	}
+	if len(response.Result) == 0 {
+		return nil, fmt.Errorf("host(s) %s: %w", strings.Join(patterns, ", "), errNotFound)
+	}
+	return response.Result, nil
+}
+
+// maintenanceCoversHost returns true if the maintenance covers the host, directly or through one
+// of its host groups. The maintenance must have been read with selectHosts and selectGroups.
+func maintenanceCoversHost(m *zabbix.Maintenance, host *zabbix.Host) bool {
+	for _, h := range m.Hosts {
+		if h.HostID == host.HostID {
+			return true
+		}
+	}
+	for _, g := range m.Groups {
+		for _, hg := range host.HostGroups {
+			if g.GroupID == hg.GroupID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// updateMaintenance sends the given maintenance to maintenance.update.
+func updateMaintenance(ctx context.Context, z *zabbix.Client, maintenance zabbix.Maintenance) error {
+	_, err := z.MaintenanceUpdate(ctx, zabbix.NewMaintenanceUpdateRequest(
+		zabbix.WithMaintenanceUpdateMaintenance(maintenance),
+		zabbix.WithMaintenanceUpdateAuthToken(z.Auth()),
+		zabbix.WithMaintenanceUpdateRequestID(1),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to update maintenance %s: %w", maintenance.MaintenanceID, err)
+	}
+	return nil
+}
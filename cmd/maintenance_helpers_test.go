@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// maintenanceGetFixture is shaped like a real maintenance.get response with selectGroups,
+// selectHosts, selectTags and selectTimeperiods: every number is returned as a string and every
+// field of every time period is set.
+const maintenanceGetFixture = `[{
+	"maintenanceid":"3","name":"Weekly patching","maintenance_type":"0","description":"Kernel updates",
+	"active_since":"1760911200","active_till":"1792447200","tags_evaltype":"0",
+	"groups":[{"groupid":"2","name":"Linux servers"}],
+	"hosts":[{"hostid":"10084","host":"web01","name":"Web 01"}],
+	"timeperiods":[{"timeperiod_type":"2","every":"1","month":"0","dayofweek":"1","day":"0",
+		"start_time":"7200","period":"5400","start_date":"1760911200"}],
+	"tags":[{"tag":"service","operator":"0","value":"web"}]
+},{
+	"maintenanceid":"4","name":"Deploy","maintenance_type":"1","description":"",
+	"active_since":"1761000000","active_till":"1761003600","tags_evaltype":"0",
+	"groups":[],
+	"hosts":[{"hostid":"10085","host":"db01","name":"db01"}],
+	"timeperiods":[{"timeperiod_type":"0","every":"1","month":"0","dayofweek":"0","day":"1",
+		"start_time":"0","period":"3600","start_date":"1761000000"}],
+	"tags":[]
+}]`
+
+// newMaintenanceTestServer returns a Zabbix API stub answering maintenance.get with maintenanceGetFixture.
+func newMaintenanceTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		result := "[]"
+		if req.Method == zabbix.MethodMaintenanceGet {
+			result = maintenanceGetFixture
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","result":%s,"id":1}`, result)
+	}))
+}
+
+func TestGetMaintenancesWithDetails(t *testing.T) {
+	t.Parallel()
+	ts := newMaintenanceTestServer(t)
+	defer ts.Close()
+	client := zabbix.New("user", "pass", ts.URL)
+
+	maintenances, err := getMaintenancesWithDetails(context.Background(), &client)
+	require.NoError(t, err)
+	require.Len(t, maintenances, 2)
+	assert.Equal(t, []zabbix.ProblemTag{{Tag: "service", Value: "web"}}, maintenances[0].Tags)
+	assert.Equal(t, zabbix.TimePeriod{
+		TimePeriodType: zabbix.TimePeriodTypeWeekly, Every: 1, DayOfWeek: 1, StartTime: 7200, Period: 5400,
+	}, maintenances[0].TimePeriods[0].Normalized())
+	assert.Equal(t, zabbix.MaintenanceNoDataCollection, maintenances[1].MaintenanceType)
+}
@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+)
+
+// icsMaxLineLength is the maximum length of an iCalendar content line, in octets (RFC 5545, 3.1).
+const icsMaxLineLength = 75
+
+// icsUTCLayout and icsLocalLayout are the iCalendar DATE-TIME formats.
+const (
+	icsUTCLayout   = "20060102T150405Z"
+	icsLocalLayout = "20060102T150405"
+)
+
+// icsWeekdays are the iCalendar weekday codes, indexed by time.Weekday.
+var icsWeekdays = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// icsWriter writes iCalendar content lines, folded and terminated by CRLF.
+type icsWriter struct {
+	out io.Writer
+	err error
+}
+
+// line writes a content line, folding it at icsMaxLineLength octets without splitting UTF-8 characters.
+func (w *icsWriter) line(format string, args ...any) {
+	if w.err != nil {
+		return
+	}
+	s := fmt.Sprintf(format, args...)
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		size := len(string(r))
+		if width+size > icsMaxLineLength {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+	_, w.err = io.WriteString(w.out, b.String())
+}
+
+// icsEscape escapes a TEXT property value.
+func icsEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// writeMaintenanceICS writes the maintenances as an iCalendar file with one event per time period.
+// Recurring periods become recurring events bounded by active_till. Times are written in the
+// time zone loc, so that recurrences follow daylight saving time as Zabbix does.
+func writeMaintenanceICS(out io.Writer, maintenances []zabbix.Maintenance, now time.Time, loc *time.Location) error {
+	w := &icsWriter{out: out}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:-//zabbix-cli//maintenance//EN")
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	w.line("X-WR-CALNAME:Zabbix maintenances")
+	if tzid := icsTZID(loc); tzid != "" {
+		w.line("X-WR-TIMEZONE:%s", tzid)
+	}
+	for i := range maintenances {
+		m := &maintenances[i]
+		for j := range m.TimePeriods {
+			writeTimePeriodEvent(w, m, j, now, loc)
+		}
+	}
+	w.line("END:VCALENDAR")
+	if w.err != nil {
+		return fmt.Errorf("failed to write iCalendar: %w", w.err)
+	}
+	return nil
+}
+
+// writeTimePeriodEvent writes the VEVENT of a time period. Periods without any occurrence in
+// the active window of the maintenance are skipped.
+func writeTimePeriodEvent(w *icsWriter, m *zabbix.Maintenance, index int, now time.Time, loc *time.Location) {
+	tp := m.TimePeriods[index].Normalized()
+	activeSince := time.Unix(m.ActiveSince.Int64(), 0).In(loc)
+	activeTill := time.Unix(m.ActiveTill.Int64(), 0).In(loc)
+
+	// The first occurrence that starts in the active window is the start of the series.
+	var first *zabbix.Occurrence
+	for _, o := range tp.Occurrences(activeSince, activeSince, activeTill) {
+		if !o.Start.Before(activeSince) {
+			first = &o
+			break
+		}
+	}
+	if first == nil {
+		return
+	}
+
+	uid := m.TimePeriods[index].TimePeriodID
+	if uid == "" {
+		uid = fmt.Sprint(index)
+	}
+	w.line("BEGIN:VEVENT")
+	w.line("UID:maintenance-%s-period-%s@zabbix-cli", m.MaintenanceID, uid)
+	w.line("DTSTAMP:%s", now.UTC().Format(icsUTCLayout))
+	if tzid := icsTZID(loc); tzid != "" {
+		w.line("DTSTART;TZID=%s:%s", tzid, first.Start.Format(icsLocalLayout))
+		w.line("DTEND;TZID=%s:%s", tzid, first.End.Format(icsLocalLayout))
+	} else {
+		w.line("DTSTART:%s", first.Start.UTC().Format(icsUTCLayout))
+		w.line("DTEND:%s", first.End.UTC().Format(icsUTCLayout))
+	}
+	if rule := icsRecurrenceRule(&tp); rule != "" {
+		w.line("RRULE:%s;UNTIL=%s", rule, activeTill.UTC().Format(icsUTCLayout))
+	}
+	summary := m.Name
+	if m.MaintenanceType == zabbix.MaintenanceNoDataCollection {
+		summary += " (no data collection)"
+	}
+	w.line("SUMMARY:%s", icsEscape(summary))
+	w.line("DESCRIPTION:%s", icsEscape(maintenanceICSDescription(m)))
+	w.line("CATEGORIES:Zabbix maintenance")
+	w.line("TRANSP:TRANSPARENT")
+	w.line("END:VEVENT")
+}
+
+// icsRecurrenceRule returns the RRULE of a normalized recurring time period, without UNTIL,
+// or "" for a one-time period.
+func icsRecurrenceRule(tp *zabbix.TimePeriod) string {
+	switch tp.TimePeriodType {
+	case zabbix.TimePeriodTypeDaily:
+		return fmt.Sprintf("FREQ=DAILY;INTERVAL=%d", tp.Every)
+	case zabbix.TimePeriodTypeWeekly:
+		return fmt.Sprintf("FREQ=WEEKLY;INTERVAL=%d;BYDAY=%s;WKST=MO", tp.Every, icsWeekdays[tp.DayOfWeek])
+	case zabbix.TimePeriodTypeMonthly:
+		return fmt.Sprintf("FREQ=MONTHLY;INTERVAL=%d;BYMONTHDAY=%d", tp.Every, tp.Day)
+	case zabbix.TimePeriodTypeMonthlyByWeekday:
+		week := tp.Every
+		if week == lastWeekOfMonth {
+			week = -1
+		}
+		return fmt.Sprintf("FREQ=MONTHLY;BYDAY=%d%s", week, icsWeekdays[tp.DayOfWeek])
+	case zabbix.TimePeriodTypeYearly:
+		return fmt.Sprintf("FREQ=YEARLY;BYMONTH=%d;BYMONTHDAY=%d", tp.Month, tp.Day)
+	default:
+		return ""
+	}
+}
+
+// maintenanceICSDescription returns the description of the events of a maintenance: its own
+// description followed by the host groups, hosts and tags it covers.
+func maintenanceICSDescription(m *zabbix.Maintenance) string {
+	var lines []string
+	if m.Description != "" {
+		lines = append(lines, m.Description, "")
+	}
+	groups := make([]string, 0, len(m.Groups))
+	for _, g := range m.Groups {
+		groups = append(groups, g.Name)
+	}
+	hosts := make([]string, 0, len(m.Hosts))
+	for _, h := range m.Hosts {
+		hosts = append(hosts, h.DisplayName())
+	}
+	if len(groups) > 0 {
+		lines = append(lines, "Host groups: "+strings.Join(groups, ", "))
+	}
+	if len(hosts) > 0 {
+		lines = append(lines, "Hosts: "+strings.Join(hosts, ", "))
+	}
+	if len(m.Tags) > 0 {
+		tags := make([]string, 0, len(m.Tags))
+		for _, t := range m.Tags {
+			tags = append(tags, formatProblemTag(t))
+		}
+		lines = append(lines, "Only problems tagged: "+strings.Join(tags, ", "))
+	}
+	lines = append(lines, "Maintenance ID: "+m.MaintenanceID)
+	return strings.Join(lines, "\n")
+}
+
+// icsTZID returns the IANA name of the location, or "" when it has none (UTC or an unnamed local zone).
+func icsTZID(loc *time.Location) string {
+	if name := loc.String(); name != "UTC" && name != "Local" && name != "" {
+		return name
+	}
+	return ""
+}
+
+// icsLocation returns the local time zone as a named location, from $TZ or /etc/localtime,
+// so that events can reference it by TZID. It falls back to UTC.
+func icsLocation() *time.Location {
+	name := os.Getenv("TZ")
+	if name == "" {
+		if target, err := os.Readlink("/etc/localtime"); err == nil {
+			if _, zone, found := strings.Cut(target, "zoneinfo/"); found {
+				name = zone
+			}
+		}
+	}
+	if name != "" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
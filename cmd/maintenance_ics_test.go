@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteMaintenanceICS(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	since := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	maintenances := []zabbix.Maintenance{{
+		MaintenanceID:   "12",
+		Name:            "Patch, reboot",
+		Description:     "OS updates",
+		MaintenanceType: zabbix.MaintenanceNoDataCollection,
+		ActiveSince:     zabbix.StringInt64(since.Unix()),
+		ActiveTill:      zabbix.StringInt64(since.AddDate(0, 3, 0).Unix()),
+		Groups:          []zabbix.HostGroup{{GroupID: "2", Name: "Linux servers"}},
+		Hosts:           []zabbix.Host{{HostID: "10084", Host: "db01", Name: "Database"}},
+		TimePeriods: []zabbix.TimePeriod{
+			{TimePeriodID: "31", TimePeriodType: zabbix.TimePeriodTypeWeekly, Every: 2, DayOfWeek: 4, StartTime: 2 * 3600, Period: 5400},
+			{TimePeriodID: "32", TimePeriodType: zabbix.TimePeriodTypeMonthlyByWeekday, Every: 5, DayOfWeek: 0, StartTime: 0, Period: 3600},
+			{TimePeriodID: "33", TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: since.Add(time.Hour).Unix(), Period: 600},
+			// Before active_since: skipped.
+			{TimePeriodID: "34", TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: since.Add(-time.Hour).Unix(), Period: 600},
+		},
+	}}
+
+	var out bytes.Buffer
+	require.NoError(t, writeMaintenanceICS(&out, maintenances, now, time.UTC))
+	ics := out.String()
+	lines := strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n")
+
+	assert.Equal(t, "BEGIN:VCALENDAR", lines[0])
+	assert.Equal(t, "END:VCALENDAR", lines[len(lines)-1])
+	assert.Equal(t, 3, strings.Count(ics, "BEGIN:VEVENT"))
+	assert.Contains(t, lines, "UID:maintenance-12-period-31@zabbix-cli")
+	assert.Contains(t, lines, "DTSTART:20261022T020000Z")
+	assert.Contains(t, lines, "DTEND:20261022T033000Z")
+	assert.Contains(t, lines, "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TH;WKST=MO;UNTIL=20270119T000000Z")
+	assert.Contains(t, lines, "DTSTART:20261025T000000Z")
+	assert.Contains(t, lines, "RRULE:FREQ=MONTHLY;BYDAY=-1SU;UNTIL=20270119T000000Z")
+	assert.Contains(t, lines, "DTSTART:20261019T010000Z")
+	assert.Contains(t, lines, `SUMMARY:Patch\, reboot (no data collection)`)
+	assert.Contains(t, strings.ReplaceAll(ics, "\r\n ", ""), `DESCRIPTION:OS updates\n\nHost groups: Linux servers\nHosts: Database\nMaintenance ID: 12`+"\r\n")
+	assert.NotContains(t, ics, "period-34")
+	for _, line := range lines {
+		assert.LessOrEqual(t, len(line), icsMaxLineLength, line)
+	}
+}
+
+func TestICSRecurrenceRule(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]zabbix.TimePeriod{
+		"FREQ=DAILY;INTERVAL=3":                 {TimePeriodType: zabbix.TimePeriodTypeDaily, Every: 3},
+		"FREQ=MONTHLY;INTERVAL=1;BYMONTHDAY=15": {TimePeriodType: zabbix.TimePeriodTypeMonthly, Every: 1, Day: 15},
+		"FREQ=MONTHLY;BYDAY=2TU":                {TimePeriodType: zabbix.TimePeriodTypeMonthlyByWeekday, Every: 2, DayOfWeek: 2},
+		"FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=24":  {TimePeriodType: zabbix.TimePeriodTypeYearly, Month: 12, Day: 24},
+		"":                                      {TimePeriodType: zabbix.TimePeriodTypeOneTime},
+	}
+	for want, tp := range tests {
+		assert.Equal(t, want, icsRecurrenceRule(&tp))
+	}
+}
+
+func TestICSWriterFolding(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	w := &icsWriter{out: &out}
+	w.line("DESCRIPTION:%s", strings.Repeat("é", 40))
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\r\n"), "\r\n")
+	require.Len(t, lines, 2)
+	assert.LessOrEqual(t, len(lines[0]), icsMaxLineLength)
+	assert.True(t, strings.HasPrefix(lines[1], " "))
+	assert.Equal(t, "DESCRIPTION:"+strings.Repeat("é", 40), lines[0]+lines[1][1:])
+}
@@ -0,0 +1,371 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	maintenanceLintHorizon   string
+	maintenanceLintMaxHosts  int
+	maintenanceLintMaxNoData string
+)
+
+// Rules checked by 'maintenance lint'.
+const (
+	lintRuleOverlap      = "overlap"
+	lintRuleExpired      = "expired"
+	lintRuleTooManyHosts = "too-many-hosts"
+	lintRuleLongNoData   = "long-no-data"
+)
+
+// lintTimeLayout is the layout of the times in lint findings.
+const lintTimeLayout = "Mon 2006-01-02 15:04"
+
+// lintOptions are the thresholds of 'maintenance lint'.
+type lintOptions struct {
+	from      time.Time
+	to        time.Time
+	maxHosts  int           // 0 disables the too-many-hosts rule
+	maxNoData time.Duration // 0 disables the long-no-data rule
+}
+
+// lintFinding is a problem found by 'maintenance lint'.
+type lintFinding struct {
+	rule         string
+	maintenances []string // "#id name"
+	message      string
+}
+
+// coveredHosts maps maintenance IDs to the IDs of the hosts they cover, directly or through their groups.
+type coveredHosts map[string][]string
+
+// MaintenanceLintCmd represents the maintenance lint subcommand
+var MaintenanceLintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Report overlapping, expired and risky maintenances",
+	Long: `Expand the time periods of every maintenance over the next --horizon and report:
+
+  overlap         two maintenances in effect at the same time on the same hosts
+  expired         maintenances whose active window has ended
+  too-many-hosts  maintenances covering more than --max-hosts hosts
+  long-no-data    hosts without data collection for longer than --max-no-data in a row,
+                  including when several no-data maintenances follow or overlap each other
+
+Hosts covered through host groups count as covered. The command exits with status 1 when it
+finds something, so that it can run in CI. Set a threshold to 0 to disable its rule.
+
+Examples:
+  zabbix-cli maintenance lint
+  zabbix-cli maintenance lint --horizon 90d --max-hosts 50 --max-no-data 12h`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		findings, err := runMaintenanceLint(cmd)
+		if err != nil {
+			return err
+		}
+		if findings > 0 {
+			return silentError(cmd, errLintFindings)
+		}
+		return nil
+	},
+}
+
+func init() {
+	MaintenanceLintCmd.Flags().StringVar(&maintenanceLintHorizon, "horizon", "30d", "How far ahead the time periods are expanded")
+	MaintenanceLintCmd.Flags().IntVar(&maintenanceLintMaxHosts, "max-hosts", 100, "Report maintenances covering more hosts than this")
+	MaintenanceLintCmd.Flags().StringVar(&maintenanceLintMaxNoData, "max-no-data", "24h", "Report hosts without data collection for longer than this")
+}
+
+// runMaintenanceLint prints the findings and returns their number.
+func runMaintenanceLint(cmd *cobra.Command) (int, error) {
+	ctx := context.Background()
+
+	horizon, err := parseDuration(maintenanceLintHorizon)
+	if err != nil {
+		return 0, err
+	}
+	maxNoData, err := parseDuration(maintenanceLintMaxNoData)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	opts := lintOptions{from: now, to: now.Add(horizon), maxHosts: maintenanceLintMaxHosts, maxNoData: maxNoData}
+
+	z, logout, err := newClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer logout()
+
+	maintenances, err := getMaintenancesWithDetails(ctx, z)
+	if err != nil {
+		return 0, err
+	}
+	covered, err := getMaintenanceHosts(ctx, z, maintenances)
+	if err != nil {
+		return 0, err
+	}
+
+	findings := lintMaintenances(maintenances, covered, opts)
+	printLintFindings(cmd.OutOrStdout(), findings)
+	return len(findings), nil
+}
+
+// getMaintenanceHosts returns the hosts covered by each maintenance, directly or through its groups.
+func getMaintenanceHosts(ctx context.Context, z *zabbix.Client, maintenances []zabbix.Maintenance) (coveredHosts, error) {
+	var groupIDs []string
+	for _, m := range maintenances {
+		for _, g := range m.Groups {
+			groupIDs = append(groupIDs, g.GroupID)
+		}
+	}
+
+	hostsByGroup := make(map[string][]string)
+	if len(groupIDs) > 0 {
+		response, err := z.HostGet(ctx, zabbix.NewHostGetRequest(
+			zabbix.WithHostGetGroupIDs(groupIDs),
+			zabbix.WithHostGetOutput([]string{"hostid"}),
+			zabbix.WithHostGetSelectHostGroups([]string{"groupid"}),
+			zabbix.WithHostGetAuth(z.Auth()),
+			zabbix.WithHostGetID(1),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the hosts of the maintenance groups: %w", err)
+		}
+		for _, h := range response.Result {
+			for _, g := range h.HostGroups {
+				hostsByGroup[g.GroupID] = append(hostsByGroup[g.GroupID], h.HostID)
+			}
+		}
+	}
+
+	covered := make(coveredHosts, len(maintenances))
+	for _, m := range maintenances {
+		seen := make(map[string]bool)
+		var hostIDs []string
+		add := func(id string) {
+			if !seen[id] {
+				seen[id] = true
+				hostIDs = append(hostIDs, id)
+			}
+		}
+		for _, h := range m.Hosts {
+			add(h.HostID)
+		}
+		for _, g := range m.Groups {
+			for _, id := range hostsByGroup[g.GroupID] {
+				add(id)
+			}
+		}
+		covered[m.MaintenanceID] = hostIDs
+	}
+	return covered, nil
+}
+
+// lintMaintenances checks the maintenances and returns the findings, rule by rule.
+func lintMaintenances(maintenances []zabbix.Maintenance, covered coveredHosts, opts lintOptions) []lintFinding {
+	var findings []lintFinding
+	occurrences := make(map[string][]zabbix.Occurrence, len(maintenances))
+	for i := range maintenances {
+		occurrences[maintenances[i].MaintenanceID] = maintenances[i].Occurrences(opts.from, opts.to)
+	}
+
+	// Overlapping windows on common hosts.
+	for i := range maintenances {
+		for j := i + 1; j < len(maintenances); j++ {
+			a, b := &maintenances[i], &maintenances[j]
+			common := countCommon(covered[a.MaintenanceID], covered[b.MaintenanceID])
+			if common == 0 {
+				continue
+			}
+			overlaps := overlappingIntervals(occurrences[a.MaintenanceID], occurrences[b.MaintenanceID])
+			if len(overlaps) == 0 {
+				continue
+			}
+			findings = append(findings, lintFinding{
+				rule:         lintRuleOverlap,
+				maintenances: []string{maintenanceLabel(a), maintenanceLabel(b)},
+				message: fmt.Sprintf("%d overlapping window(s) on %d common host(s), first %s -> %s",
+					len(overlaps), common, overlaps[0].Start.Format(lintTimeLayout), overlaps[0].End.Format(lintTimeLayout)),
+			})
+		}
+	}
+
+	// Expired maintenances.
+	for i := range maintenances {
+		m := &maintenances[i]
+		if till := time.Unix(m.ActiveTill.Int64(), 0); till.Before(opts.from) {
+			findings = append(findings, lintFinding{
+				rule:         lintRuleExpired,
+				maintenances: []string{maintenanceLabel(m)},
+				message:      fmt.Sprintf("active window ended on %s", till.In(opts.from.Location()).Format(lintTimeLayout)),
+			})
+		}
+	}
+
+	// Maintenances covering too many hosts.
+	if opts.maxHosts > 0 {
+		for i := range maintenances {
+			m := &maintenances[i]
+			if n := len(covered[m.MaintenanceID]); n > opts.maxHosts {
+				findings = append(findings, lintFinding{
+					rule:         lintRuleTooManyHosts,
+					maintenances: []string{maintenanceLabel(m)},
+					message:      fmt.Sprintf("covers %d hosts (more than %d)", n, opts.maxHosts),
+				})
+			}
+		}
+	}
+
+	if opts.maxNoData > 0 {
+		findings = append(findings, lintLongNoData(maintenances, covered, occurrences, opts)...)
+	}
+	return findings
+}
+
+// lintLongNoData returns the periods during which hosts are continuously without data collection
+// for longer than opts.maxNoData, merging the windows of all the no-data maintenances of each host.
+// Hosts sharing the same period and maintenances are reported together.
+func lintLongNoData(maintenances []zabbix.Maintenance, covered coveredHosts,
+	occurrences map[string][]zabbix.Occurrence, opts lintOptions) []lintFinding {
+	type window struct {
+		zabbix.Occurrence
+		maintenance *zabbix.Maintenance
+	}
+	windowsByHost := make(map[string][]window)
+	for i := range maintenances {
+		m := &maintenances[i]
+		if m.MaintenanceType != zabbix.MaintenanceNoDataCollection {
+			continue
+		}
+		for _, hostID := range covered[m.MaintenanceID] {
+			for _, o := range occurrences[m.MaintenanceID] {
+				windowsByHost[hostID] = append(windowsByHost[hostID], window{o, m})
+			}
+		}
+	}
+
+	type key struct {
+		start, end   time.Time
+		maintenances string
+	}
+	hostsByKey := make(map[key]int)
+	var keys []key
+	for _, windows := range windowsByHost {
+		sort.Slice(windows, func(i, j int) bool { return windows[i].Start.Before(windows[j].Start) })
+		for i := 0; i < len(windows); {
+			merged := windows[i].Occurrence
+			labels := map[string]bool{maintenanceLabel(windows[i].maintenance): true}
+			j := i + 1
+			for ; j < len(windows) && !windows[j].Start.After(merged.End); j++ {
+				if windows[j].End.After(merged.End) {
+					merged.End = windows[j].End
+				}
+				labels[maintenanceLabel(windows[j].maintenance)] = true
+			}
+			i = j
+			if merged.End.Sub(merged.Start) <= opts.maxNoData {
+				continue
+			}
+			k := key{merged.Start, merged.End, strings.Join(sortedKeys(labels), "\x00")}
+			if _, ok := hostsByKey[k]; !ok {
+				keys = append(keys, k)
+			}
+			hostsByKey[k]++
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].start.Equal(keys[j].start) {
+			return keys[i].start.Before(keys[j].start)
+		}
+		return keys[i].maintenances < keys[j].maintenances
+	})
+	findings := make([]lintFinding, 0, len(keys))
+	for _, k := range keys {
+		findings = append(findings, lintFinding{
+			rule:         lintRuleLongNoData,
+			maintenances: strings.Split(k.maintenances, "\x00"),
+			message: fmt.Sprintf("no data collected for %s (%s -> %s) on %d host(s)", formatDuration(k.end.Sub(k.start)),
+				k.start.Format(lintTimeLayout), k.end.Format(lintTimeLayout), hostsByKey[k]),
+		})
+	}
+	return findings
+}
+
+// overlappingIntervals returns the intersections of two sorted lists of intervals.
+func overlappingIntervals(a, b []zabbix.Occurrence) []zabbix.Occurrence {
+	var overlaps []zabbix.Occurrence
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start, end := a[i].Start, a[i].End
+		if b[j].Start.After(start) {
+			start = b[j].Start
+		}
+		if b[j].End.Before(end) {
+			end = b[j].End
+		}
+		if start.Before(end) {
+			overlaps = append(overlaps, zabbix.Occurrence{Start: start, End: end})
+		}
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return overlaps
+}
+
+// countCommon returns the number of strings present in both a and b.
+func countCommon(a, b []string) int {
+	set := make(map[string]bool, len(a))
+	for _, s := range a {
+		set[s] = true
+	}
+	n := 0
+	for _, s := range b {
+		if set[s] {
+			n++
+		}
+	}
+	return n
+}
+
+// sortedKeys returns the keys of a set, sorted.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// maintenanceLabel identifies a maintenance in messages.
+func maintenanceLabel(m *zabbix.Maintenance) string {
+	return fmt.Sprintf("#%s %s", m.MaintenanceID, m.Name)
+}
+
+// printLintFindings prints the findings as a table followed by a summary.
+func printLintFindings(out io.Writer, findings []lintFinding) {
+	if len(findings) == 0 {
+		fmt.Fprintln(out, "No finding")
+		return
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "RULE\tMAINTENANCES\tFINDING")
+	for _, f := range findings {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", f.rule, strings.Join(f.maintenances, ", "), f.message)
+	}
+	w.Flush()
+	fmt.Fprintf(out, "%d finding(s)\n", len(findings))
+}
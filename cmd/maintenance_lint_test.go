@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintMaintenances(t *testing.T) {
+	t.Parallel()
+
+	// Friday 2026-10-23 00:00 UTC.
+	from := time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC)
+	since := zabbix.StringInt64(from.AddDate(0, 0, -7).Unix())
+	till := zabbix.StringInt64(from.AddDate(0, 1, 0).Unix())
+	saturday := from.AddDate(0, 0, 1)
+	maintenances := []zabbix.Maintenance{
+		// Two no-data maintenances back to back over the weekend.
+		{MaintenanceID: "1", Name: "Saturday", MaintenanceType: zabbix.MaintenanceNoDataCollection, ActiveSince: since, ActiveTill: till,
+			TimePeriods: []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: saturday.Unix(), Period: 26 * 3600}}},
+		{MaintenanceID: "2", Name: "Sunday", MaintenanceType: zabbix.MaintenanceNoDataCollection, ActiveSince: since, ActiveTill: till,
+			TimePeriods: []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: saturday.Add(24 * time.Hour).Unix(), Period: 24 * 3600}}},
+		// Same hosts, different time.
+		{MaintenanceID: "3", Name: "Nightly", ActiveSince: since, ActiveTill: till,
+			TimePeriods: []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeDaily, Every: 1, StartTime: 12 * 3600, Period: 3600}}},
+		{MaintenanceID: "4", Name: "Old", ActiveSince: since, ActiveTill: zabbix.StringInt64(from.AddDate(0, 0, -1).Unix()),
+			TimePeriods: []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeDaily, Every: 1, Period: 3600}}},
+	}
+	covered := coveredHosts{
+		"1": {"h1", "h2", "h3"},
+		"2": {"h2", "h3"},
+		"3": {"h1", "h4"},
+		"4": {"h1"},
+	}
+
+	findings := lintMaintenances(maintenances, covered, lintOptions{
+		from: from, to: from.AddDate(0, 0, 7), maxHosts: 2, maxNoData: 24 * time.Hour,
+	})
+
+	var out bytes.Buffer
+	printLintFindings(&out, findings)
+	assert.Equal(t, `RULE             MAINTENANCES              FINDING
+overlap          #1 Saturday, #2 Sunday    1 overlapping window(s) on 2 common host(s), first Sun 2026-10-25 00:00 -> Sun 2026-10-25 02:00
+overlap          #1 Saturday, #3 Nightly   1 overlapping window(s) on 1 common host(s), first Sat 2026-10-24 12:00 -> Sat 2026-10-24 13:00
+expired          #4 Old                    active window ended on Thu 2026-10-22 00:00
+too-many-hosts   #1 Saturday               covers 3 hosts (more than 2)
+long-no-data     #1 Saturday               no data collected for 1d2h (Sat 2026-10-24 00:00 -> Sun 2026-10-25 02:00) on 1 host(s)
+long-no-data     #1 Saturday, #2 Sunday    no data collected for 2d (Sat 2026-10-24 00:00 -> Mon 2026-10-26 00:00) on 2 host(s)
+6 finding(s)
+`, out.String())
+
+	findings = lintMaintenances(maintenances[2:3], covered, lintOptions{from: from, to: from.AddDate(0, 0, 7)})
+	assert.Empty(t, findings)
+	out.Reset()
+	printLintFindings(&out, findings)
+	assert.Equal(t, "No finding\n", out.String())
+}
+
+func TestOverlappingIntervals(t *testing.T) {
+	t.Parallel()
+
+	at := func(h int) time.Time { return time.Date(2026, 1, 1, h, 0, 0, 0, time.UTC) }
+	a := []zabbix.Occurrence{{Start: at(0), End: at(4)}, {Start: at(10), End: at(12)}}
+	b := []zabbix.Occurrence{{Start: at(1), End: at(2)}, {Start: at(3), End: at(11)}, {Start: at(12), End: at(13)}}
+	assert.Equal(t, []zabbix.Occurrence{
+		{Start: at(1), End: at(2)},
+		{Start: at(3), End: at(4)},
+		{Start: at(10), End: at(11)},
+	}, overlappingIntervals(a, b))
+}
+
+func TestLintMaintenancesFromServer(t *testing.T) {
+	t.Parallel()
+	ts := newMaintenanceTestServer(t)
+	defer ts.Close()
+	client := zabbix.New("user", "pass", ts.URL)
+	ctx := context.Background()
+
+	maintenances, err := getMaintenancesWithDetails(ctx, &client)
+	require.NoError(t, err)
+	covered, err := getMaintenanceHosts(ctx, &client, maintenances)
+	require.NoError(t, err)
+	assert.Equal(t, coveredHosts{"3": {"10084"}, "4": {"10085"}}, covered)
+
+	from := time.Date(2025, 10, 19, 0, 0, 0, 0, time.UTC)
+	findings := lintMaintenances(maintenances, covered, lintOptions{from: from, to: from.AddDate(0, 0, 7), maxNoData: 30 * time.Minute})
+
+	var out bytes.Buffer
+	printLintFindings(&out, findings)
+	assert.Equal(t, `RULE           MAINTENANCES   FINDING
+long-no-data   #4 Deploy      no data collected for 1h (Mon 2025-10-20 22:40 -> Mon 2025-10-20 23:40) on 1 host(s)
+1 finding(s)
+`, out.String())
+}
@@ -0,0 +1,299 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/pflag"
+)
+
+// defaultRecurringWindow is how long a recurring maintenance stays active when --end is not given.
+const defaultRecurringWindow = 365 * 24 * time.Hour
+
+// lastWeekOfMonth is the --week-of-month value meaning the last week of the month.
+const lastWeekOfMonth = 5
+
+// weekdays maps day names to TimePeriod.DayOfWeek values (0=Sunday).
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// maintenanceSchedule holds the flags describing when a maintenance is active.
+type maintenanceSchedule struct {
+	start       string
+	end         string
+	duration    string
+	every       string
+	interval    int
+	days        []string
+	daysOfMonth []int
+	weekOfMonth int
+	months      []string
+	at          string
+	occurrences int
+}
+
+// maintenanceWindow is the resolved schedule of a maintenance.
+type maintenanceWindow struct {
+	activeSince time.Time
+	activeTill  time.Time
+	periods     []zabbix.TimePeriod
+}
+
+// addFlags registers the schedule flags on the given flag set.
+func (s *maintenanceSchedule) addFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&s.start, "start", "now", "Start of the maintenance ('2026-10-20 22:00', RFC3339, or a delay such as '2h')")
+	flags.StringVar(&s.end, "end", "", "End of the maintenance (default: start + duration, or start + 1 year for recurring maintenances)")
+	flags.StringVarP(&s.duration, "duration", "D", "1h", "Duration of the maintenance or of each occurrence (e.g., '90m', '2h', '1d'; a plain number is hours)")
+	flags.StringVar(&s.every, "every", "", "Recurrence: 'day', 'week', 'month' or 'year' (default: one-time)")
+	flags.IntVar(&s.interval, "interval", 1, "Repeat every N days, weeks or months")
+	flags.StringSliceVar(&s.days, "days", nil, "Days of the week for weekly or monthly recurrences (e.g., 'mon,thu')")
+	flags.IntSliceVar(&s.daysOfMonth, "day", nil, "Days of the month (1-31) for monthly or yearly recurrences")
+	flags.IntVar(&s.weekOfMonth, "week-of-month", 0, "Week of the month (1-4, or 5 for the last one) for monthly recurrences on --days")
+	flags.StringSliceVar(&s.months, "months", nil, "Months for yearly recurrences (e.g., 'jan,jul' or '1,7')")
+	flags.StringVar(&s.at, "at", "00:00", "Start time of each occurrence of a recurring maintenance (HH:MM)")
+	flags.IntVar(&s.occurrences, "occurrences", 5, "Number of upcoming occurrences to print before creating the maintenance")
+}
+
+// window resolves the schedule flags relative to now.
+func (s *maintenanceSchedule) window(flags *pflag.FlagSet, now time.Time) (*maintenanceWindow, error) {
+	start, err := parseTimeOrDuration(s.start, now)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --start: %w", err)
+	}
+	duration, err := parseMaintenanceDuration(s.duration)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &maintenanceWindow{activeSince: start}
+	if s.end != "" {
+		if w.activeTill, err = parseTimeOrDuration(s.end, start); err != nil {
+			return nil, fmt.Errorf("invalid --end: %w", err)
+		}
+	}
+
+	if s.every == "" {
+		if s.end != "" && flags.Changed("duration") {
+			return nil, fmt.Errorf("--end and --duration are mutually exclusive for a one-time maintenance")
+		}
+		if s.end != "" {
+			duration = w.activeTill.Sub(start)
+		} else {
+			w.activeTill = start.Add(duration)
+		}
+		w.periods = []zabbix.TimePeriod{{
+			TimePeriodType: zabbix.TimePeriodTypeOneTime,
+			StartDate:      start.Unix(),
+			Period:         int(duration.Seconds()),
+		}}
+	} else {
+		if s.end == "" {
+			w.activeTill = start.Add(defaultRecurringWindow)
+		}
+		if w.periods, err = s.recurringPeriods(duration); err != nil {
+			return nil, err
+		}
+	}
+
+	if !w.activeTill.After(w.activeSince) {
+		return nil, fmt.Errorf("the maintenance must end after it starts (%s)", w.activeSince.Format(time.RFC3339))
+	}
+	for i := range w.periods {
+		if err := w.periods[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// recurringPeriods translates the recurrence flags into time periods, one per day, weekday or month.
+func (s *maintenanceSchedule) recurringPeriods(duration time.Duration) ([]zabbix.TimePeriod, error) {
+	startTime, err := parseClock(s.at)
+	if err != nil {
+		return nil, err
+	}
+	if s.interval < 1 {
+		return nil, fmt.Errorf("--interval must be at least 1, got %d", s.interval)
+	}
+	days, err := parseWeekdays(s.days)
+	if err != nil {
+		return nil, err
+	}
+	months, err := parseMonths(s.months)
+	if err != nil {
+		return nil, err
+	}
+	base := zabbix.TimePeriod{StartTime: startTime, Period: int(duration.Seconds())}
+
+	var periods []zabbix.TimePeriod
+	switch strings.ToLower(s.every) {
+	case "day":
+		if len(days) > 0 || len(s.daysOfMonth) > 0 || len(months) > 0 {
+			return nil, fmt.Errorf("--days, --day and --months cannot be used with --every day")
+		}
+		tp := base
+		tp.TimePeriodType = zabbix.TimePeriodTypeDaily
+		tp.Every = s.interval
+		periods = append(periods, tp)
+	case "week":
+		if len(days) == 0 {
+			return nil, fmt.Errorf("--every week requires --days")
+		}
+		for _, d := range days {
+			tp := base
+			tp.TimePeriodType = zabbix.TimePeriodTypeWeekly
+			tp.Every = s.interval
+			tp.DayOfWeek = int(d)
+			periods = append(periods, tp)
+		}
+	case "month":
+		switch {
+		case len(days) > 0 && len(s.daysOfMonth) > 0:
+			return nil, fmt.Errorf("--days and --day are mutually exclusive for monthly recurrences")
+		case len(days) > 0:
+			if s.interval != 1 {
+				return nil, fmt.Errorf("--interval cannot be used with monthly recurrences on --days")
+			}
+			if s.weekOfMonth == 0 {
+				return nil, fmt.Errorf("--every month --days requires --week-of-month (1-4, or %d for the last week)", lastWeekOfMonth)
+			}
+			for _, d := range days {
+				tp := base
+				tp.TimePeriodType = zabbix.TimePeriodTypeMonthlyByWeekday
+				tp.Every = s.weekOfMonth
+				tp.DayOfWeek = int(d)
+				periods = append(periods, tp)
+			}
+		case len(s.daysOfMonth) > 0:
+			for _, d := range s.daysOfMonth {
+				tp := base
+				tp.TimePeriodType = zabbix.TimePeriodTypeMonthly
+				tp.Every = s.interval
+				tp.Day = d
+				periods = append(periods, tp)
+			}
+		default:
+			return nil, fmt.Errorf("--every month requires --day or --days with --week-of-month")
+		}
+	case "year":
+		if len(months) == 0 || len(s.daysOfMonth) == 0 {
+			return nil, fmt.Errorf("--every year requires --months and --day")
+		}
+		for _, m := range months {
+			for _, d := range s.daysOfMonth {
+				tp := base
+				tp.TimePeriodType = zabbix.TimePeriodTypeYearly
+				tp.Month = int(m)
+				tp.Day = d
+				periods = append(periods, tp)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("invalid --every %q (expected 'day', 'week', 'month' or 'year')", s.every)
+	}
+	return periods, nil
+}
+
+// nextOccurrences returns up to n occurrences of the window starting from now, clipped to the active window.
+func (w *maintenanceWindow) nextOccurrences(now time.Time, n int) []zabbix.Occurrence {
+	m := zabbix.Maintenance{
+		ActiveSince: zabbix.StringInt64(w.activeSince.Unix()),
+		ActiveTill:  zabbix.StringInt64(w.activeTill.Unix()),
+		TimePeriods: w.periods,
+	}
+	all := m.Occurrences(now.In(w.activeSince.Location()), w.activeTill)
+	if len(all) > n {
+		all = all[:n]
+	}
+	return all
+}
+
+// printOccurrences prints the active window and the next occurrences of the maintenance.
+func (w *maintenanceWindow) printOccurrences(out io.Writer, now time.Time, n int) {
+	fmt.Fprintf(out, "Active from: %s\n", w.activeSince.Format(time.RFC3339))
+	fmt.Fprintf(out, "Active until: %s\n", w.activeTill.Format(time.RFC3339))
+	occurrences := w.nextOccurrences(now, n)
+	if len(occurrences) == 0 {
+		fmt.Fprintln(out, "Warning: the maintenance has no occurrence in its active window")
+		return
+	}
+	fmt.Fprintln(out, "Next occurrences:")
+	for _, o := range occurrences {
+		fmt.Fprintf(out, "  %s -> %s (%s)\n", o.Start.Format("Mon 2006-01-02 15:04"), o.End.Format("Mon 2006-01-02 15:04"), o.End.Sub(o.Start))
+	}
+}
+
+// parseMaintenanceDuration parses a --duration value. A plain number is a number of hours,
+// as in previous versions of the command.
+func parseMaintenanceDuration(value string) (time.Duration, error) {
+	d, err := parseDuration(value)
+	if hours, convErr := strconv.Atoi(strings.TrimSpace(value)); convErr == nil {
+		d, err = time.Duration(hours)*time.Hour, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("--duration must be positive, got %q", value)
+	}
+	if d%time.Second != 0 {
+		return 0, fmt.Errorf("--duration must be a whole number of seconds, got %q", value)
+	}
+	return d, nil
+}
+
+// parseClock parses a HH:MM time of day and returns the number of seconds since midnight.
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q (expected HH:MM)", value)
+	}
+	return t.Hour()*3600 + t.Minute()*60, nil
+}
+
+// parseWeekdays parses day names such as "mon" or "Thursday".
+func parseWeekdays(values []string) ([]time.Weekday, error) {
+	days := make([]time.Weekday, 0, len(values))
+	for _, v := range values {
+		name := strings.ToLower(strings.TrimSpace(v))
+		if len(name) >= 3 {
+			if d, ok := weekdays[name[:3]]; ok && strings.HasPrefix(strings.ToLower(d.String()), name) {
+				days = append(days, d)
+				continue
+			}
+		}
+		return nil, fmt.Errorf("invalid day of the week %q (expected e.g. 'mon' or 'thursday')", v)
+	}
+	return days, nil
+}
+
+// parseMonths parses month names ("jan", "July") or numbers (1-12).
+func parseMonths(values []string) ([]time.Month, error) {
+	months := make([]time.Month, 0, len(values))
+	for _, v := range values {
+		name := strings.ToLower(strings.TrimSpace(v))
+		if n, err := strconv.Atoi(name); err == nil && n >= 1 && n <= 12 {
+			months = append(months, time.Month(n))
+			continue
+		}
+		found := false
+		for m := time.January; m <= time.December; m++ {
+			full := strings.ToLower(m.String())
+			if len(name) >= 3 && strings.HasPrefix(full, name) {
+				months = append(months, m)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("invalid month %q (expected e.g. 'jan', 'july' or 1-12)", v)
+		}
+	}
+	return months, nil
+}
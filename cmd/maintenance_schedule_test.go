@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scheduleWindow parses the schedule flags from args and resolves the window at now.
+func scheduleWindow(t *testing.T, now time.Time, args ...string) (*maintenanceWindow, error) {
+	t.Helper()
+	var s maintenanceSchedule
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	s.addFlags(flags)
+	require.NoError(t, flags.Parse(args))
+	return s.window(flags, now)
+}
+
+func TestMaintenanceScheduleOneTime(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	w, err := scheduleWindow(t, now)
+	require.NoError(t, err)
+	assert.Equal(t, now, w.activeSince)
+	assert.Equal(t, now.Add(time.Hour), w.activeTill)
+	assert.Equal(t, []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: now.Unix(), Period: 3600}}, w.periods)
+
+	// A plain number is a number of hours, as with the former integer flag.
+	w, err = scheduleWindow(t, now, "-D", "2")
+	require.NoError(t, err)
+	assert.Equal(t, 7200, w.periods[0].Period)
+
+	w, err = scheduleWindow(t, now, "--start", "2026-10-20 22:00", "--duration", "90m")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 20, 22, 0, 0, 0, time.Local), w.activeSince)
+	assert.Equal(t, 5400, w.periods[0].Period)
+
+	w, err = scheduleWindow(t, now, "--start", "2026-10-20 22:00", "--end", "2026-10-21 01:00")
+	require.NoError(t, err)
+	assert.Equal(t, 3*3600, w.periods[0].Period)
+
+	_, err = scheduleWindow(t, now, "--end", "2026-10-21 01:00", "--duration", "1h")
+	require.Error(t, err)
+	_, err = scheduleWindow(t, now, "--end", "2026-10-01")
+	require.Error(t, err)
+	_, err = scheduleWindow(t, now, "--duration", "-1h")
+	require.Error(t, err)
+}
+
+func TestMaintenanceScheduleRecurring(t *testing.T) {
+	t.Parallel()
+	// Sunday
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+
+	w, err := scheduleWindow(t, now, "--every", "week", "--days", "mon,thu", "--at", "02:00", "--duration", "1h30m")
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(defaultRecurringWindow), w.activeTill)
+	require.Len(t, w.periods, 2)
+	assert.Equal(t, zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeWeekly, Every: 1, DayOfWeek: 1, StartTime: 7200, Period: 5400}, w.periods[0])
+	assert.Equal(t, 4, w.periods[1].DayOfWeek)
+
+	occ := w.nextOccurrences(now, 3)
+	require.Len(t, occ, 3)
+	assert.Equal(t, time.Date(2026, 10, 19, 2, 0, 0, 0, time.Local), occ[0].Start)
+	assert.Equal(t, time.Date(2026, 10, 22, 2, 0, 0, 0, time.Local), occ[1].Start)
+	assert.Equal(t, time.Date(2026, 10, 26, 2, 0, 0, 0, time.Local), occ[2].Start)
+
+	w, err = scheduleWindow(t, now, "--every", "month", "--days", "sunday", "--week-of-month", "5", "--end", "2027-01-01")
+	require.NoError(t, err)
+	assert.Equal(t, zabbix.TimePeriodTypeMonthlyByWeekday, w.periods[0].TimePeriodType)
+	assert.Equal(t, 5, w.periods[0].Every)
+
+	w, err = scheduleWindow(t, now, "--every", "year", "--months", "jan,7", "--day", "1")
+	require.NoError(t, err)
+	require.Len(t, w.periods, 2)
+	assert.Equal(t, 7, w.periods[1].Month)
+
+	for _, args := range [][]string{
+		{"--every", "week"},
+		{"--every", "week", "--days", "funday"},
+		{"--every", "month"},
+		{"--every", "month", "--day", "32"},
+		{"--every", "month", "--days", "mon", "--week-of-month", "6"},
+		{"--every", "year", "--months", "13", "--day", "1"},
+		{"--every", "day", "--at", "25:00"},
+		{"--every", "fortnight"},
+	} {
+		_, err := scheduleWindow(t, now, args...)
+		assert.Error(t, err, args)
+	}
+}
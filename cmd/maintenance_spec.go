@@ -0,0 +1,394 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"gopkg.in/yaml.v3"
+)
+
+// specTimeLayout is the layout of the times written in maintenance specs. It includes the
+// time zone so that files can be applied from any machine.
+const specTimeLayout = time.RFC3339
+
+// timePeriodTypeNames are the names of the time period types in maintenance specs.
+var timePeriodTypeNames = map[zabbix.TimePeriodType]string{
+	zabbix.TimePeriodTypeOneTime:          "one-time",
+	zabbix.TimePeriodTypeDaily:            "daily",
+	zabbix.TimePeriodTypeWeekly:           "weekly",
+	zabbix.TimePeriodTypeMonthly:          "monthly",
+	zabbix.TimePeriodTypeMonthlyByWeekday: "monthly-by-weekday",
+	zabbix.TimePeriodTypeYearly:           "yearly",
+}
+
+// maintenanceSpecFile is the document read by 'maintenance apply' and written by 'maintenance get -o yaml'.
+type maintenanceSpecFile struct {
+	Maintenances []maintenanceSpec `yaml:"maintenances"`
+}
+
+// maintenanceSpec is the declarative description of a maintenance. Hosts and groups are referenced by name.
+type maintenanceSpec struct {
+	Name           string           `yaml:"name"`
+	Description    string           `yaml:"description,omitempty"`
+	DataCollection *bool            `yaml:"data_collection,omitempty"` // default: true
+	ActiveSince    string           `yaml:"active_since"`
+	ActiveTill     string           `yaml:"active_till"`
+	Hosts          []string         `yaml:"hosts,omitempty"`
+	Groups         []string         `yaml:"groups,omitempty"`
+	Tags           []tagSpec        `yaml:"tags,omitempty"`
+	TagsEval       string           `yaml:"tags_eval,omitempty"` // and (default) or or
+	Periods        []timePeriodSpec `yaml:"periods"`
+}
+
+// Operators of the tags of a maintenance spec.
+const (
+	tagOperatorContains = "contains"
+	tagOperatorEquals   = "equals"
+)
+
+// tagSpec is a problem tag of a maintenance spec.
+type tagSpec struct {
+	Tag      string `yaml:"tag"`
+	Value    string `yaml:"value,omitempty"`
+	Operator string `yaml:"operator,omitempty"` // contains (default, as in Zabbix) or equals
+}
+
+// tagSpecFromProblemTag converts a maintenance tag to a spec tag, omitting the default operator.
+func tagSpecFromProblemTag(t zabbix.ProblemTag) tagSpec {
+	spec := tagSpec{Tag: t.Tag, Value: t.Value}
+	if t.Operator == zabbix.MaintenanceTagOperatorEquals {
+		spec.Operator = tagOperatorEquals
+	}
+	return spec
+}
+
+// problemTag converts a spec tag to a maintenance tag. The operator is always set, so that the
+// default of the spec and the one of the server are the same.
+func (t tagSpec) problemTag() (zabbix.ProblemTag, error) {
+	tag := zabbix.ProblemTag{Tag: t.Tag, Value: t.Value}
+	switch t.Operator {
+	case "", tagOperatorContains:
+		tag.Operator = zabbix.MaintenanceTagOperatorContains
+	case tagOperatorEquals:
+		tag.Operator = zabbix.MaintenanceTagOperatorEquals
+	default:
+		return tag, fmt.Errorf("invalid tag operator %q (expected 'contains' or 'equals')", t.Operator)
+	}
+	return tag, nil
+}
+
+// timePeriodSpec is a time period of a maintenance spec. Only the fields relevant to the type are used.
+type timePeriodSpec struct {
+	Type      string `yaml:"type"`                  // one-time, daily, weekly, monthly, monthly-by-weekday or yearly
+	Start     string `yaml:"start,omitempty"`       // one-time: start date
+	At        string `yaml:"at,omitempty"`          // recurring: start time (HH:MM)
+	Duration  string `yaml:"duration"`              // length of each occurrence (e.g. 1h30m)
+	Every     int    `yaml:"every,omitempty"`       // frequency, or week of the month (1-5) for monthly-by-weekday
+	DayOfWeek string `yaml:"day_of_week,omitempty"` // weekly, monthly-by-weekday (e.g. mon)
+	Day       int    `yaml:"day,omitempty"`         // monthly, yearly (1-31)
+	Month     string `yaml:"month,omitempty"`       // yearly (e.g. jan)
+	Year      int    `yaml:"year,omitempty"`        // yearly (optional)
+}
+
+// readMaintenanceSpecs reads maintenance specs from a YAML file ("-" for stdin).
+func readMaintenanceSpecs(path string, stdin io.Reader) ([]maintenanceSpec, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", path, err)
+	}
+
+	var file maintenanceSpecFile
+	decoder := yaml.NewDecoder(strings.NewReader(string(data)))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("cannot parse %s: %w", path, err)
+	}
+
+	seen := make(map[string]bool, len(file.Maintenances))
+	for i, spec := range file.Maintenances {
+		if spec.Name == "" {
+			return nil, fmt.Errorf("%s: maintenance #%d has no name", path, i+1)
+		}
+		if seen[spec.Name] {
+			return nil, fmt.Errorf("%s: duplicate maintenance %q", path, spec.Name)
+		}
+		seen[spec.Name] = true
+	}
+	return file.Maintenances, nil
+}
+
+// writeMaintenanceSpecs writes maintenances as a YAML spec file.
+func writeMaintenanceSpecs(out io.Writer, maintenances []zabbix.Maintenance) error {
+	file := maintenanceSpecFile{Maintenances: make([]maintenanceSpec, 0, len(maintenances))}
+	for i := range maintenances {
+		file.Maintenances = append(file.Maintenances, specFromMaintenance(&maintenances[i]))
+	}
+	encoder := yaml.NewEncoder(out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(file); err != nil {
+		return fmt.Errorf("failed to marshal maintenances to YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to marshal maintenances to YAML: %w", err)
+	}
+	return nil
+}
+
+// specFromMaintenance converts a maintenance, as returned by maintenance.get with selectGroups,
+// selectHosts, selectTags and selectTimeperiods, to a spec.
+func specFromMaintenance(m *zabbix.Maintenance) maintenanceSpec {
+	spec := maintenanceSpec{
+		Name:        m.Name,
+		Description: m.Description,
+		ActiveSince: time.Unix(m.ActiveSince.Int64(), 0).Format(specTimeLayout),
+		ActiveTill:  time.Unix(m.ActiveTill.Int64(), 0).Format(specTimeLayout),
+	}
+	if m.MaintenanceType == zabbix.MaintenanceNoDataCollection {
+		dataCollection := false
+		spec.DataCollection = &dataCollection
+	}
+	for _, h := range m.Hosts {
+		spec.Hosts = append(spec.Hosts, h.Host)
+	}
+	for _, g := range m.Groups {
+		spec.Groups = append(spec.Groups, g.Name)
+	}
+	sort.Strings(spec.Hosts)
+	sort.Strings(spec.Groups)
+	for _, t := range m.Tags {
+		spec.Tags = append(spec.Tags, tagSpecFromProblemTag(t))
+	}
+	if len(m.Tags) > 0 && m.TagsEvalType == zabbix.TagsEvalTypeOr {
+		spec.TagsEval = "or"
+	}
+	for _, tp := range m.TimePeriods {
+		spec.Periods = append(spec.Periods, specFromTimePeriod(tp.Normalized()))
+	}
+	return spec
+}
+
+// specFromTimePeriod converts a normalized time period to a spec.
+func specFromTimePeriod(tp zabbix.TimePeriod) timePeriodSpec {
+	spec := timePeriodSpec{
+		Type:     timePeriodTypeNames[tp.TimePeriodType],
+		Duration: (time.Duration(tp.Period) * time.Second).String(),
+		Every:    tp.Every,
+		Day:      tp.Day,
+		Year:     tp.Year,
+	}
+	if tp.TimePeriodType == zabbix.TimePeriodTypeOneTime {
+		spec.Start = time.Unix(tp.StartDate, 0).Format(specTimeLayout)
+		return spec
+	}
+	spec.At = fmt.Sprintf("%02d:%02d", tp.StartTime/3600, tp.StartTime%3600/60)
+	if tp.TimePeriodType == zabbix.TimePeriodTypeWeekly || tp.TimePeriodType == zabbix.TimePeriodTypeMonthlyByWeekday {
+		spec.DayOfWeek = strings.ToLower(time.Weekday(tp.DayOfWeek).String()[:3])
+	}
+	if tp.TimePeriodType == zabbix.TimePeriodTypeYearly {
+		spec.Month = strings.ToLower(time.Month(tp.Month).String()[:3])
+	}
+	return spec
+}
+
+// toTimePeriod converts a time period spec to a validated time period.
+func (s *timePeriodSpec) toTimePeriod() (zabbix.TimePeriod, error) {
+	tp := zabbix.TimePeriod{Every: s.Every, Day: s.Day, Year: s.Year}
+	found := false
+	for t, name := range timePeriodTypeNames {
+		if strings.EqualFold(s.Type, name) {
+			tp.TimePeriodType = t
+			found = true
+		}
+	}
+	if !found {
+		return tp, fmt.Errorf("invalid period type %q", s.Type)
+	}
+
+	duration, err := parseDuration(s.Duration)
+	if err != nil {
+		return tp, err
+	}
+	tp.Period = int(duration.Seconds())
+
+	if tp.TimePeriodType == zabbix.TimePeriodTypeOneTime {
+		start, err := parseTime(s.Start)
+		if err != nil {
+			return tp, fmt.Errorf("invalid one-time period start: %w", err)
+		}
+		tp.StartDate = start.Unix()
+	} else if s.At != "" {
+		if tp.StartTime, err = parseClock(s.At); err != nil {
+			return tp, err
+		}
+	}
+	if s.DayOfWeek != "" {
+		days, err := parseWeekdays([]string{s.DayOfWeek})
+		if err != nil {
+			return tp, err
+		}
+		tp.DayOfWeek = int(days[0])
+	}
+	if s.Month != "" {
+		months, err := parseMonths([]string{s.Month})
+		if err != nil {
+			return tp, err
+		}
+		tp.Month = int(months[0])
+	}
+
+	tp = tp.Normalized()
+	if err := tp.Validate(); err != nil {
+		return tp, err
+	}
+	return tp, nil
+}
+
+// toMaintenance converts a spec to a maintenance, resolving host and group names to IDs.
+func (s *maintenanceSpec) toMaintenance(ctx context.Context, z *zabbix.Client) (zabbix.Maintenance, error) {
+	m := zabbix.Maintenance{
+		Name:            s.Name,
+		Description:     s.Description,
+		MaintenanceType: zabbix.MaintenanceWithDataCollection,
+	}
+	if s.DataCollection != nil && !*s.DataCollection {
+		m.MaintenanceType = zabbix.MaintenanceNoDataCollection
+	}
+
+	activeSince, err := parseTime(s.ActiveSince)
+	if err != nil {
+		return m, fmt.Errorf("maintenance %q: invalid active_since: %w", s.Name, err)
+	}
+	activeTill, err := parseTime(s.ActiveTill)
+	if err != nil {
+		return m, fmt.Errorf("maintenance %q: invalid active_till: %w", s.Name, err)
+	}
+	if !activeTill.After(activeSince) {
+		return m, fmt.Errorf("maintenance %q: active_till must be after active_since", s.Name)
+	}
+	m.ActiveSince = zabbix.StringInt64(activeSince.Unix())
+	m.ActiveTill = zabbix.StringInt64(activeTill.Unix())
+
+	if len(s.Periods) == 0 {
+		return m, fmt.Errorf("maintenance %q: at least one period is required", s.Name)
+	}
+	for i := range s.Periods {
+		tp, err := s.Periods[i].toTimePeriod()
+		if err != nil {
+			return m, fmt.Errorf("maintenance %q, period #%d: %w", s.Name, i+1, err)
+		}
+		m.TimePeriods = append(m.TimePeriods, tp)
+	}
+
+	for _, t := range s.Tags {
+		tag, err := t.problemTag()
+		if err != nil {
+			return m, fmt.Errorf("maintenance %q: %w", s.Name, err)
+		}
+		m.Tags = append(m.Tags, tag)
+	}
+	if m.TagsEvalType, err = parseTagsEvalType(defaultString(s.TagsEval, "and")); err != nil {
+		return m, fmt.Errorf("maintenance %q: %w", s.Name, err)
+	}
+
+	if len(s.Hosts) == 0 && len(s.Groups) == 0 {
+		return m, fmt.Errorf("maintenance %q: %w", s.Name, errNoMaintenanceTarget)
+	}
+	target, err := resolveMaintenanceTarget(ctx, z, s.Hosts, s.Groups, false)
+	if err != nil {
+		return m, fmt.Errorf("maintenance %q: %w", s.Name, err)
+	}
+	m.HostIDs = target.hostIDs
+	m.GroupIDs = target.groupIDs
+	return m, nil
+}
+
+// defaultString returns value, or def if value is empty.
+func defaultString(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
+
+// maintenanceDiff returns the names of the fields of desired that differ from current.
+// Both maintenances use IDs for hosts and groups (see Maintenance.UpdateParams).
+func maintenanceDiff(current, desired *zabbix.Maintenance) []string {
+	var changed []string
+	if current.Description != desired.Description {
+		changed = append(changed, "description")
+	}
+	if current.MaintenanceType != desired.MaintenanceType {
+		changed = append(changed, "data_collection")
+	}
+	if current.ActiveSince != desired.ActiveSince {
+		changed = append(changed, "active_since")
+	}
+	if current.ActiveTill != desired.ActiveTill {
+		changed = append(changed, "active_till")
+	}
+	if !sameStrings(current.HostIDs, desired.HostIDs) {
+		changed = append(changed, "hosts")
+	}
+	if !sameStrings(current.GroupIDs, desired.GroupIDs) {
+		changed = append(changed, "groups")
+	}
+	if !reflect.DeepEqual(sortedTags(current.Tags), sortedTags(desired.Tags)) {
+		changed = append(changed, "tags")
+	}
+	if len(desired.Tags) > 0 && current.TagsEvalType != desired.TagsEvalType {
+		changed = append(changed, "tags_eval")
+	}
+	if !reflect.DeepEqual(sortedTimePeriods(current.TimePeriods), sortedTimePeriods(desired.TimePeriods)) {
+		changed = append(changed, "periods")
+	}
+	return changed
+}
+
+// sameStrings returns true if a and b contain the same strings, in any order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return reflect.DeepEqual(a, b)
+}
+
+// sortedTags returns a sorted copy of tags, nil if empty.
+func sortedTags(tags []zabbix.ProblemTag) []zabbix.ProblemTag {
+	if len(tags) == 0 {
+		return nil
+	}
+	sorted := append([]zabbix.ProblemTag(nil), tags...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return fmt.Sprint(sorted[i]) < fmt.Sprint(sorted[j])
+	})
+	return sorted
+}
+
+// sortedTimePeriods returns a sorted copy of normalized time periods.
+func sortedTimePeriods(periods []zabbix.TimePeriod) []zabbix.TimePeriod {
+	sorted := make([]zabbix.TimePeriod, 0, len(periods))
+	for _, tp := range periods {
+		sorted = append(sorted, tp.Normalized())
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return fmt.Sprint(sorted[i]) < fmt.Sprint(sorted[j])
+	})
+	return sorted
+}
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimePeriodSpecRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 10, 20, 22, 0, 0, 0, time.Local)
+	periods := []zabbix.TimePeriod{
+		{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: start.Unix(), Period: 7200},
+		{TimePeriodType: zabbix.TimePeriodTypeDaily, Every: 2, StartTime: 3600, Period: 1800},
+		{TimePeriodType: zabbix.TimePeriodTypeWeekly, Every: 1, DayOfWeek: 4, StartTime: 7200, Period: 5400},
+		{TimePeriodType: zabbix.TimePeriodTypeMonthly, Every: 3, Day: 15, StartTime: 0, Period: 3600},
+		{TimePeriodType: zabbix.TimePeriodTypeMonthlyByWeekday, Every: 5, DayOfWeek: 0, StartTime: 79200, Period: 3600},
+		{TimePeriodType: zabbix.TimePeriodTypeYearly, Month: 12, Day: 24, StartTime: 64800, Period: 86400},
+	}
+	for _, tp := range periods {
+		spec := specFromTimePeriod(tp)
+		got, err := spec.toTimePeriod()
+		require.NoError(t, err, spec.Type)
+		assert.Equal(t, tp, got, spec.Type)
+	}
+
+	weekly := specFromTimePeriod(periods[2])
+	assert.Equal(t, timePeriodSpec{Type: "weekly", At: "02:00", Duration: "1h30m0s", Every: 1, DayOfWeek: "thu"}, weekly)
+}
+
+func TestTimePeriodSpecErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []timePeriodSpec{
+		{Type: "hourly", Duration: "1h"},
+		{Type: "one-time", Duration: "1h", Start: "tomorrow"},
+		{Type: "daily", Duration: "soon"},
+		{Type: "weekly", Duration: "1h", DayOfWeek: "someday"},
+		{Type: "weekly", Duration: "1h", At: "25:00", DayOfWeek: "mon"},
+		{Type: "monthly", Duration: "1h", Day: 32},
+		{Type: "yearly", Duration: "1h", Month: "smarch", Day: 1},
+	}
+	for _, spec := range tests {
+		_, err := spec.toTimePeriod()
+		assert.Error(t, err, "%+v", spec)
+	}
+}
+
+func TestSpecFromMaintenance(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := zabbix.Maintenance{
+		MaintenanceID:   "7",
+		Name:            "Patch Tuesday",
+		ActiveSince:     zabbix.StringInt64(since.Unix()),
+		ActiveTill:      zabbix.StringInt64(since.AddDate(1, 0, 0).Unix()),
+		MaintenanceType: zabbix.MaintenanceNoDataCollection,
+		Groups:          []zabbix.HostGroup{{GroupID: "3", Name: "Zeta"}, {GroupID: "2", Name: "Alpha"}},
+		Hosts:           []zabbix.Host{{HostID: "10084", Host: "web01", Name: "Web server"}},
+		Tags: []zabbix.ProblemTag{
+			{Tag: "service", Value: "os", Operator: zabbix.MaintenanceTagOperatorContains},
+			{Tag: "env", Value: "prod", Operator: zabbix.MaintenanceTagOperatorEquals},
+		},
+		TagsEvalType: zabbix.TagsEvalTypeOr,
+		TimePeriods: []zabbix.TimePeriod{{TimePeriodID: "9", TimePeriodType: zabbix.TimePeriodTypeMonthlyByWeekday,
+			Every: 2, DayOfWeek: 2, Day: 1, Month: 1, StartTime: 79200, Period: 7200}},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, writeMaintenanceSpecs(&out, []zabbix.Maintenance{m}))
+	want := `maintenances:
+  - name: Patch Tuesday
+    data_collection: false
+    active_since: "` + time.Unix(since.Unix(), 0).Format(time.RFC3339) + `"
+    active_till: "` + time.Unix(since.AddDate(1, 0, 0).Unix(), 0).Format(time.RFC3339) + `"
+    hosts:
+      - web01
+    groups:
+      - Alpha
+      - Zeta
+    tags:
+      - tag: service
+        value: os
+      - tag: env
+        value: prod
+        operator: equals
+    tags_eval: or
+    periods:
+      - type: monthly-by-weekday
+        at: "22:00"
+        duration: 2h0m0s
+        every: 2
+        day_of_week: tue
+`
+	assert.Equal(t, want, out.String())
+
+	path := filepath.Join(t.TempDir(), "windows.yaml")
+	require.NoError(t, os.WriteFile(path, out.Bytes(), 0o600))
+	specs, err := readMaintenanceSpecs(path, nil)
+	require.NoError(t, err)
+	require.Len(t, specs, 1)
+	assert.Equal(t, specFromMaintenance(&m), specs[0])
+}
+
+func TestTagSpecProblemTag(t *testing.T) {
+	t.Parallel()
+
+	// A tag without operator uses the default of the server, so that apply sees no change.
+	tag, err := tagSpec{Tag: "service", Value: "web"}.problemTag()
+	require.NoError(t, err)
+	assert.Equal(t, zabbix.ProblemTag{Tag: "service", Value: "web", Operator: zabbix.MaintenanceTagOperatorContains}, tag)
+	assert.Empty(t, maintenanceDiff(&zabbix.Maintenance{Tags: []zabbix.ProblemTag{tag}},
+		&zabbix.Maintenance{Tags: []zabbix.ProblemTag{{Tag: "service", Value: "web", Operator: 2}}}))
+
+	tag, err = tagSpec{Tag: "service", Value: "web", Operator: "equals"}.problemTag()
+	require.NoError(t, err)
+	assert.Equal(t, zabbix.MaintenanceTagOperatorEquals, tag.Operator)
+
+	_, err = tagSpec{Tag: "service", Operator: "like"}.problemTag()
+	require.Error(t, err)
+}
+
+func TestReadMaintenanceSpecsErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"unknown field": "maintenances:\n  - name: a\n    colour: red\n",
+		"no name":       "maintenances:\n  - description: a\n",
+		"duplicate":     "maintenances:\n  - name: a\n  - name: a\n",
+	}
+	for name, content := range tests {
+		_, err := readMaintenanceSpecs("-", strings.NewReader(content))
+		assert.Error(t, err, name)
+	}
+
+	specs, err := readMaintenanceSpecs("-", strings.NewReader(""))
+	require.NoError(t, err)
+	assert.Empty(t, specs)
+}
+
+func TestMaintenanceDiff(t *testing.T) {
+	t.Parallel()
+
+	current := zabbix.Maintenance{
+		Name: "backup", ActiveSince: 1000, ActiveTill: 2000,
+		HostIDs: []string{"2", "1"}, Tags: []zabbix.ProblemTag{{Tag: "a"}, {Tag: "b"}}, TagsEvalType: zabbix.TagsEvalTypeOr,
+		// As returned by the server, with every field set.
+		TimePeriods: []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeDaily, Every: 1, Day: 1, Month: 1, StartTime: 60, Period: 600}},
+	}
+	desired := zabbix.Maintenance{
+		Name: "backup", ActiveSince: 1000, ActiveTill: 2000,
+		HostIDs: []string{"1", "2"}, Tags: []zabbix.ProblemTag{{Tag: "b"}, {Tag: "a"}}, TagsEvalType: zabbix.TagsEvalTypeOr,
+		TimePeriods: []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeDaily, StartTime: 60, Period: 600}},
+	}
+	assert.Empty(t, maintenanceDiff(&current, &desired))
+
+	desired.Description = "nightly"
+	desired.ActiveTill = 3000
+	desired.GroupIDs = []string{"5"}
+	desired.Tags = nil
+	desired.TimePeriods[0].Period = 900
+	assert.Equal(t, []string{"description", "active_till", "groups", "tags", "periods"}, maintenanceDiff(&current, &desired))
+}
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	maintenanceUpdateName        string
+	maintenanceUpdateDescription string
+	maintenanceUpdateType        int
+	maintenanceUpdateSched       maintenanceSchedule
+	maintenanceUpdateHosts       []string
+	maintenanceUpdateGroups      []string
+	maintenanceUpdateTags        []string
+	maintenanceUpdateTagsEval    string
+	maintenanceUpdateClearTags   bool
+	maintenanceUpdateDryRun      bool
+)
+
+// maintenanceScheduleFlags are the flags that redefine the schedule of a maintenance.
+var maintenanceScheduleFlags = []string{
+	"start", "end", "duration", "every", "interval", "days", "day", "week-of-month", "months", "at",
+}
+
+// MaintenanceUpdateCmd represents the maintenance update subcommand
+var MaintenanceUpdateCmd = &cobra.Command{
+	Use:   "update <id|name>",
+	Short: "Update a maintenance period",
+	Long: `Update the name, description, type, schedule, hosts, host groups or tags of a maintenance.
+Fields that are not given on the command line are preserved.
+
+--host and --group (names or globs) replace the hosts and host groups of the maintenance,
+--tag replaces its tags and --clear-tags removes them.
+
+The schedule flags (--start, --end, --duration, --every, ...) redefine the whole schedule, with
+the same meaning as for 'maintenance create'. --start defaults to the current start of the
+one-time period, or of the maintenance. A recurring schedule is only redefined with --every,
+so that it is not turned into a one-time period by mistake.
+
+Examples:
+  zabbix-cli maintenance update "Deploy web" --name "Deploy web (v2)" --description "ticket OPS-42"
+  zabbix-cli maintenance update 12 --host web01 --host web02
+  zabbix-cli maintenance update 12 --duration 3h
+  zabbix-cli maintenance update 12 --every week --days sat --at 23:00 --duration 2h`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		flags := cmd.Flags()
+
+		if flags.Changed("tag") && maintenanceUpdateClearTags {
+			return fmt.Errorf("--tag and --clear-tags are mutually exclusive")
+		}
+		tags, err := parseTags(maintenanceUpdateTags)
+		if err != nil {
+			return err
+		}
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		current, err := getMaintenance(ctx, z, args[0])
+		if err != nil {
+			return err
+		}
+		maintenance := current.UpdateParams()
+
+		changed := false
+		if flags.Changed("name") {
+			maintenance.Name = maintenanceUpdateName
+			changed = true
+		}
+		if flags.Changed("description") {
+			maintenance.Description = maintenanceUpdateDescription
+			changed = true
+		}
+		if flags.Changed("type") {
+			maintenance.MaintenanceType = zabbix.MaintenanceType(maintenanceUpdateType)
+			changed = true
+		}
+		if scheduleChanged(cmd) {
+			start, err := scheduleUpdateStart(&maintenance, flags.Changed("every"))
+			if err != nil {
+				return err
+			}
+			if !flags.Changed("start") {
+				maintenanceUpdateSched.start = start.Format(time.RFC3339)
+			}
+			now := time.Now()
+			window, err := maintenanceUpdateSched.window(flags, now)
+			if err != nil {
+				return err
+			}
+			window.printOccurrences(cmd.OutOrStdout(), now, maintenanceUpdateSched.occurrences)
+			maintenance.ActiveSince = zabbix.StringInt64(window.activeSince.Unix())
+			maintenance.ActiveTill = zabbix.StringInt64(window.activeTill.Unix())
+			maintenance.TimePeriods = window.periods
+			changed = true
+		}
+		if len(maintenanceUpdateHosts) > 0 || len(maintenanceUpdateGroups) > 0 {
+			target, err := resolveMaintenanceTarget(ctx, z, maintenanceUpdateHosts, maintenanceUpdateGroups, false)
+			if err != nil {
+				return err
+			}
+			if flags.Changed("host") {
+				maintenance.HostIDs = target.hostIDs
+			}
+			if flags.Changed("group") {
+				maintenance.GroupIDs = target.groupIDs
+			}
+			changed = true
+		}
+		if flags.Changed("tag") || flags.Changed("tags-eval") {
+			if flags.Changed("tag") {
+				maintenance.Tags = tags
+			}
+			if flags.Changed("tags-eval") {
+				if maintenance.TagsEvalType, err = parseTagsEvalType(maintenanceUpdateTagsEval); err != nil {
+					return err
+				}
+			}
+			changed = true
+		}
+		if maintenanceUpdateClearTags {
+			maintenance.Tags = []zabbix.ProblemTag{}
+			changed = true
+		}
+		if !changed {
+			return fmt.Errorf("nothing to update: use at least one of --name, --description, --type, --host, --group, --tag, --clear-tags or a schedule flag")
+		}
+
+		if maintenanceUpdateDryRun {
+			fmt.Fprintf(cmd.OutOrStdout(), "Dry run: maintenance %s (%s) would be updated\n", maintenance.MaintenanceID, current.Name)
+			return nil
+		}
+		if err := updateMaintenance(ctx, z, maintenance); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Maintenance %s (%s) updated successfully.\n", maintenance.MaintenanceID, maintenance.Name)
+		return nil
+	},
+}
+
+// scheduleUpdateStart returns the start of the current schedule of m, used when --start is not
+// given. It refuses to redefine a recurring schedule without --every, as the new schedule would be
+// a one-time period.
+func scheduleUpdateStart(m *zabbix.Maintenance, every bool) (time.Time, error) {
+	start := time.Unix(m.ActiveSince.Int64(), 0)
+	for _, p := range m.TimePeriods {
+		if p.TimePeriodType != zabbix.TimePeriodTypeOneTime {
+			if !every {
+				return time.Time{}, fmt.Errorf("maintenance %s has a recurring schedule: use --every to redefine it", m.MaintenanceID)
+			}
+			return start, nil
+		}
+	}
+	if !every && len(m.TimePeriods) == 1 {
+		return time.Unix(m.TimePeriods[0].StartDate, 0), nil
+	}
+	return start, nil
+}
+
+// scheduleChanged returns true if one of the schedule flags was given.
+func scheduleChanged(cmd *cobra.Command) bool {
+	for _, name := range maintenanceScheduleFlags {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	MaintenanceUpdateCmd.Flags().StringVarP(&maintenanceUpdateName, "name", "n", "", "New name of the maintenance")
+	MaintenanceUpdateCmd.Flags().StringVarP(&maintenanceUpdateDescription, "description", "d", "", "New description of the maintenance")
+	MaintenanceUpdateCmd.Flags().IntVarP(&maintenanceUpdateType, "type", "t", int(zabbix.MaintenanceWithDataCollection), "Type of maintenance (0 - with data collection, 1 - without data collection)")
+	maintenanceUpdateSched.addFlags(MaintenanceUpdateCmd.Flags())
+	MaintenanceUpdateCmd.Flags().StringArrayVar(&maintenanceUpdateHosts, "host", nil, "Replace the hosts of the maintenance, by name or glob; repeatable")
+	MaintenanceUpdateCmd.Flags().StringArrayVar(&maintenanceUpdateGroups, "group", nil, "Replace the host groups of the maintenance, by name or glob; repeatable")
+	MaintenanceUpdateCmd.Flags().StringArrayVar(&maintenanceUpdateTags, "tag", nil, "Replace the tags of the maintenance (key=value); repeatable")
+	MaintenanceUpdateCmd.Flags().StringVar(&maintenanceUpdateTagsEval, "tags-eval", "and", "How tags are combined: 'and' or 'or' (unchanged when not given)")
+	MaintenanceUpdateCmd.Flags().BoolVar(&maintenanceUpdateClearTags, "clear-tags", false, "Remove the tags of the maintenance")
+	MaintenanceUpdateCmd.Flags().BoolVar(&maintenanceUpdateDryRun, "dry-run", false, "Show what would be updated without updating the maintenance")
+}
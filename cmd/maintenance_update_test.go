@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleUpdateStart(t *testing.T) {
+	t.Parallel()
+
+	oneTime := &zabbix.Maintenance{
+		MaintenanceID: "4",
+		ActiveSince:   1760000000,
+		TimePeriods:   []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: 1761000000, Period: 3600}},
+	}
+	start, err := scheduleUpdateStart(oneTime, false)
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1761000000, 0), start)
+
+	start, err = scheduleUpdateStart(oneTime, true)
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1760000000, 0), start)
+
+	// A recurring schedule is not turned into a one-time period by --duration alone.
+	weekly := &zabbix.Maintenance{
+		MaintenanceID: "3",
+		ActiveSince:   1760000000,
+		TimePeriods:   []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeWeekly, Every: 1, DayOfWeek: 1, Period: 5400}},
+	}
+	_, err = scheduleUpdateStart(weekly, false)
+	require.ErrorContains(t, err, "--every")
+
+	start, err = scheduleUpdateStart(weekly, true)
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1760000000, 0), start)
+}
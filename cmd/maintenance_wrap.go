@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	maintenanceWrapHosts    []string
+	maintenanceWrapGroups   []string
+	maintenanceWrapTags     []string
+	maintenanceWrapTagsEval string
+	maintenanceWrapMax      string
+	maintenanceWrapName     string
+	maintenanceWrapType     int
+)
+
+// wrapCleanupTimeout bounds the deletion of the maintenance once the child has exited.
+const wrapCleanupTimeout = 30 * time.Second
+
+// wrapSignalExitBase is added to the signal number to build the exit code of a child killed by a signal,
+// as shells do.
+const wrapSignalExitBase = 128
+
+// forwardedSignals are the signals relayed to the child process.
+var forwardedSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// MaintenanceWrapCmd represents the maintenance wrap subcommand
+var MaintenanceWrapCmd = &cobra.Command{
+	Use:   "wrap [flags] -- <command> [args...]",
+	Short: "Run a command inside a maintenance period",
+	Long: `Create a one-time maintenance for the given hosts and host groups, run the command, then
+delete the maintenance when the command exits, whether it succeeds, fails or is interrupted.
+
+Signals (SIGINT, SIGTERM) received by zabbix-cli are forwarded to the command, and the exit code
+of the command is returned unchanged. A signal received while the maintenance is created skips
+the command; signals received while it is deleted are ignored. --max bounds the maintenance: if the command runs longer,
+the maintenance expires on its own while the command keeps running.
+
+Examples:
+  zabbix-cli maintenance wrap --host web01 --max 1h -- ./deploy.sh
+  zabbix-cli maintenance wrap --group 'Web servers' --tag service=nginx -- ansible-playbook site.yml`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		code, err := runMaintenanceWrap(cmd, args)
+		if err != nil {
+			return err
+		}
+		if code != 0 {
+			os.Exit(code)
+		}
+		return nil
+	},
+}
+
+func init() {
+	MaintenanceWrapCmd.Flags().SetInterspersed(false)
+	MaintenanceWrapCmd.Flags().StringArrayVar(&maintenanceWrapHosts, "host", nil, "Host to put in maintenance, by name or glob; repeatable")
+	MaintenanceWrapCmd.Flags().StringArrayVar(&maintenanceWrapGroups, "group", nil, "Host group to put in maintenance, by name or glob; repeatable")
+	MaintenanceWrapCmd.Flags().StringArrayVar(&maintenanceWrapTags, "tag", nil, "Only put problems with this tag in maintenance (key=value); repeatable")
+	MaintenanceWrapCmd.Flags().StringVar(&maintenanceWrapTagsEval, "tags-eval", "and", "How --tag values are combined: 'and' or 'or'")
+	MaintenanceWrapCmd.Flags().StringVar(&maintenanceWrapMax, "max", "1h", "Maximum duration of the maintenance (e.g., '30m', '2h')")
+	MaintenanceWrapCmd.Flags().StringVarP(&maintenanceWrapName, "name", "n", "", "Name of the maintenance (default: 'Wrap <command> <timestamp>')")
+	MaintenanceWrapCmd.Flags().IntVarP(&maintenanceWrapType, "type", "t", int(zabbix.MaintenanceWithDataCollection), "Type of maintenance (0 - with data collection, 1 - without data collection)")
+}
+
+// runMaintenanceWrap creates the maintenance, runs the command and deletes the maintenance.
+// It returns the exit code of the command.
+func runMaintenanceWrap(cmd *cobra.Command, args []string) (int, error) {
+	ctx := context.Background()
+
+	if len(maintenanceWrapHosts) == 0 && len(maintenanceWrapGroups) == 0 {
+		return 0, fmt.Errorf("no maintenance target: use --host and/or --group")
+	}
+	maxDuration, err := parseDuration(maintenanceWrapMax)
+	if err != nil {
+		return 0, err
+	}
+	if maxDuration <= 0 {
+		return 0, fmt.Errorf("--max must be a positive duration")
+	}
+	tags, err := parseTags(maintenanceWrapTags)
+	if err != nil {
+		return 0, err
+	}
+	tagsEvalType, err := parseTagsEvalType(maintenanceWrapTagsEval)
+	if err != nil {
+		return 0, err
+	}
+
+	z, logout, err := newClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer logout()
+
+	target, err := resolveMaintenanceTarget(ctx, z, maintenanceWrapHosts, maintenanceWrapGroups, false)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	commandLine := strings.Join(args, " ")
+	name := maintenanceWrapName
+	if name == "" {
+		name = fmt.Sprintf("Wrap %s %s", commandLine, now.Format("2006-01-02 15:04:05"))
+		if runes := []rune(name); len(runes) > maxMaintenanceNameLength {
+			name = string(runes[:maxMaintenanceNameLength])
+		}
+	}
+	options := []zabbix.MaintenanceCreateOption{
+		zabbix.WithMaintenanceName(name),
+		zabbix.WithMaintenanceDescription(fmt.Sprintf("Maintenance created by zabbix-cli on %s for the lifetime of: %s",
+			now.Format("2006-01-02 15:04:05"), commandLine)),
+		zabbix.WithMaintenanceActiveSince(now.Unix()),
+		zabbix.WithMaintenanceActiveTill(now.Add(maxDuration).Unix()),
+		zabbix.WithMaintenanceType(zabbix.MaintenanceType(maintenanceWrapType)),
+		zabbix.WithMaintenanceTimePeriods([]zabbix.TimePeriod{{
+			TimePeriodType: zabbix.TimePeriodTypeOneTime,
+			StartDate:      now.Unix(),
+			Period:         int(maxDuration.Seconds()),
+		}}),
+		zabbix.WithMaintenanceGroupIDs(target.groupIDs),
+		zabbix.WithMaintenanceHostIDs(target.hostIDs),
+		zabbix.WithMaintenanceAuthToken(z.Auth()),
+		zabbix.WithMaintenanceRequestID(1),
+	}
+	if len(tags) > 0 {
+		options = append(options, zabbix.WithMaintenanceTags(tags), zabbix.WithMaintenanceTagsEvalType(tagsEvalType))
+	}
+
+	// Catch the signals from now on and until the maintenance is deleted: an interruption must not
+	// leave the maintenance behind. They are forwarded to the command while it runs, and ignored
+	// during the cleanup.
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, forwardedSignals...)
+	defer signal.Stop(signals)
+
+	response, err := z.MaintenanceCreate(ctx, zabbix.NewMaintenanceCreateRequest(options...))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create maintenance: %w", err)
+	}
+	if len(response.Result.MaintenanceIDs) == 0 {
+		return 0, fmt.Errorf("failed to create maintenance: %w", zabbix.ErrEmptyResult)
+	}
+	maintenanceID := response.Result.MaintenanceIDs[0]
+	fmt.Fprintf(cmd.ErrOrStderr(), "Maintenance %s (%s) created, active until %s\n",
+		maintenanceID, name, now.Add(maxDuration).Format(time.RFC3339))
+
+	defer func() {
+		if err := deleteWrapMaintenance(z, maintenanceID); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %v\n", err)
+			return
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Maintenance %s deleted\n", maintenanceID)
+	}()
+
+	// Do not start the command if interrupted while the maintenance was created.
+	select {
+	case sig := <-signals:
+		fmt.Fprintf(cmd.ErrOrStderr(), "Interrupted by %v, %s not run\n", sig, args[0])
+		return signalExitCode(sig), nil
+	default:
+	}
+	return runForwardingSignals(args, signals, cmd.InOrStdin(), cmd.OutOrStdout(), cmd.ErrOrStderr())
+}
+
+// deleteWrapMaintenance deletes the maintenance, logging in again if the session has expired
+// while the command was running.
+func deleteWrapMaintenance(z *zabbix.Client, maintenanceID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), wrapCleanupTimeout)
+	defer cancel()
+
+	deleteMaintenance := func() error {
+		_, err := z.MaintenanceDelete(ctx, zabbix.NewMaintenanceDeleteRequest(
+			zabbix.WithMaintenanceDeleteID(maintenanceID),
+			zabbix.WithMaintenanceDeleteAuthToken(z.Auth()),
+		))
+		return err
+	}
+	err := deleteMaintenance()
+	if err != nil {
+		if loginErr := z.Login(ctx); loginErr == nil {
+			err = deleteMaintenance()
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete maintenance %s, delete it with 'zabbix-cli maintenance delete %s': %w",
+			maintenanceID, maintenanceID, err)
+	}
+	return nil
+}
+
+// runForwardingSignals runs the command with the given standard streams, forwarding the
+// signals received on signals to it, and returns its exit code.
+func runForwardingSignals(args []string, signals <-chan os.Signal, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
+	child := exec.Command(args[0], args[1:]...)
+	child.Stdin = stdin
+	child.Stdout = stdout
+	child.Stderr = stderr
+
+	if err := child.Start(); err != nil {
+		return 0, fmt.Errorf("cannot run %s: %w", args[0], err)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case sig := <-signals:
+				_ = child.Process.Signal(sig)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err := child.Wait()
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return 0, nil
+	case errors.As(err, &exitErr):
+		return exitCode(exitErr.ProcessState), nil
+	default:
+		return 0, fmt.Errorf("failed to run %s: %w", args[0], err)
+	}
+}
+
+// signalExitCode returns the exit code of a process killed by sig, as in shells.
+func signalExitCode(sig os.Signal) int {
+	if s, ok := sig.(syscall.Signal); ok {
+		return wrapSignalExitBase + int(s)
+	}
+	return wrapSignalExitBase
+}
+
+// exitCode returns the exit code of a terminated process. A process killed by a signal
+// gets 128 + the signal number, as in shells.
+func exitCode(state *os.ProcessState) int {
+	if code := state.ExitCode(); code >= 0 {
+		return code
+	}
+	if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return wrapSignalExitBase + int(status.Signal())
+	}
+	return 1
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"runtime"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunForwardingSignals(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	var stdout bytes.Buffer
+	code, err := runForwardingSignals([]string{"sh", "-c", "read line; echo \"got $line\"; exit 3"}, nil,
+		strings.NewReader("deploy\n"), &stdout, &stdout)
+	require.NoError(t, err)
+	assert.Equal(t, 3, code)
+	assert.Equal(t, "got deploy\n", stdout.String())
+
+	code, err = runForwardingSignals([]string{"true"}, nil, strings.NewReader(""), &stdout, &stdout)
+	require.NoError(t, err)
+	assert.Equal(t, 0, code)
+
+	// A child killed by SIGTERM (15) exits with 128+15, like in shells.
+	code, err = runForwardingSignals([]string{"sh", "-c", "kill -TERM $$"}, nil, strings.NewReader(""), &stdout, &stdout)
+	require.NoError(t, err)
+	assert.Equal(t, 143, code)
+
+	// Signals received by zabbix-cli are forwarded to the child.
+	signals := make(chan os.Signal, 1)
+	signals <- syscall.SIGTERM
+	code, err = runForwardingSignals([]string{"sleep", "10"}, signals, strings.NewReader(""), &stdout, &stdout)
+	require.NoError(t, err)
+	assert.Equal(t, 143, code)
+	assert.Equal(t, 130, signalExitCode(os.Interrupt))
+
+	_, err = runForwardingSignals([]string{"/nonexistent/command"}, nil, strings.NewReader(""), &stdout, &stdout)
+	require.Error(t, err)
+}
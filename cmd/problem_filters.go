@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/pflag"
+)
+
+// problemFilters holds the flags shared by every command that selects problems.
+type problemFilters struct {
+	ack       bool
+	supp      bool
+	severity  string
+	dashboard string
+}
+
+// addFlags registers the problem filter flags on the given flag set.
+func (f *problemFilters) addFlags(flags *pflag.FlagSet) {
+	flags.BoolVarP(&f.ack, "ack", "a", false, "select acknowledged problems only")
+	flags.BoolVarP(&f.supp, "supp", "s", false, "select suppressed problems only")
+	flags.StringVar(&f.severity, "severity", "", "Filter problems by severity (e.g., 'Not classified', 'Information', 'Warning', 'Average', 'High', 'Disaster')")
+	flags.StringVar(&f.dashboard, "dashboard", "", "Apply filters from named dashboard (extracts filters from first 'problems' widget)")
+}
+
+// options converts the filters to problem.get options.
+// Dashboard filters are applied first so that CLI flags override them.
+func (f *problemFilters) options(ctx context.Context, z *zabbix.Client) ([]zabbix.GetProblemOption, error) {
+	options, err := loadDashboardFilters(ctx, *z, f.dashboard)
+	if err != nil {
+		return nil, err
+	}
+
+	options = append(options, zabbix.GetProblemOptionAcknowledged(f.ack))
+	options = append(options, zabbix.GetProblemOptionSuppressed(f.supp))
+
+	if f.severity != "" {
+		severity, err := zabbix.ParseSeverity(f.severity)
+		if err != nil {
+			return nil, err
+		}
+		// ProblemParams.Severities expects []string of integer severities
+		options = append(options, zabbix.GetProblemOptionSeverities([]string{strconv.Itoa(int(severity))}))
+	}
+	return options, nil
+}
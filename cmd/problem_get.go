@@ -11,95 +11,42 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var Ack bool
-var Supp bool
-var problemSeverityFlag string
-var problemDashboardName string
+var problemGetFilters problemFilters
 
 // ProblemGetCmd represents the get problem subcommand
 var ProblemGetCmd = &cobra.Command{
 	Use:   "get",
 	Short: "get problems",
 	Long:  `get problems`,
-	Run: func(_ *cobra.Command, _ []string) {
-		var err error
+	RunE: func(_ *cobra.Command, _ []string) error {
 		ctx := context.Background()
 
-		err = initConfig()
+		z, logout, err := newClient(ctx)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v\n", err.Error())
-			os.Exit(1)
+			return err
 		}
+		defer logout()
 
-		z := zabbix.New(conf.ZabbixUser, conf.ZabbixPassword, conf.ZabbixEndpoint)
-		err = z.Login(ctx)
+		options, err := problemGetFilters.options(ctx, z)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v\n", err.Error())
-			os.Exit(1)
-		}
-		defer z.Logout(ctx) //nolint:errcheck
-
-		var options []zabbix.GetProblemOption
-
-		// Apply dashboard filters if specified
-		if problemDashboardName != "" {
-			filter := map[string]interface{}{
-				"name": problemDashboardName,
-			}
-			dashboardReq := zabbix.NewDashboardGetRequest(
-				zabbix.WithDashboardGetAuth(z.Auth()),
-				zabbix.WithDashboardGetID(1),
-				zabbix.WithDashboardGetOutput("extend"),
-				zabbix.WithDashboardGetSelectPages("extend"),
-				zabbix.WithDashboardGetFilter(filter),
-			)
-
-			dashboardResp, err := z.DashboardGet(ctx, dashboardReq)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Failed to get dashboard: %v\n", err.Error())
-				os.Exit(1)
-			}
-
-			if len(dashboardResp.Result) == 0 {
-				fmt.Fprintf(os.Stderr, "Dashboard '%s' not found\n", problemDashboardName)
-				os.Exit(1)
-			}
-
-			dashboardFilters, err := zabbix.ParseProblemsWidgetFilters(&dashboardResp.Result[0])
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Failed to parse dashboard filters: %v\n", err.Error())
-				os.Exit(1)
-			}
-
-			if len(dashboardFilters) == 0 {
-				fmt.Fprintf(os.Stderr, "Warning: Dashboard '%s' has no 'problems' widget or no filters\n", problemDashboardName)
-			}
-
-			options = append(options, dashboardFilters...)
-		}
-
-		// Apply CLI flags (these override dashboard filters if both are set)
-		options = append(options, zabbix.GetProblemOptionAcknowledged(Ack))
-		options = append(options, zabbix.GetProblemOptionSuppressed(Supp))
-
-		if problemSeverityFlag != "" {
-			severityInt := zabbix.GetSeverityString(problemSeverityFlag)
-			// ProblemParams.Severities expects []string of integer severities
-			options = append(options, zabbix.GetProblemOptionSeverities([]string{fmt.Sprintf("%d", severityInt)}))
+			return err
 		}
 		// Add SelectHosts to get host information
 		options = append(options, zabbix.GetProblemOptionSelectHosts("extend"))
 
 		res, err := z.GetProblems(ctx, options...)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v\n", err.Error())
-			os.Exit(1)
+			return fmt.Errorf("failed to get problems: %w", err)
 		}
 
-		PrettyPrintProblems(res) //nolint:errcheck
+		return PrettyPrintProblems(res)
 	},
 }
 
+func init() {
+	problemGetFilters.addFlags(ProblemGetCmd.Flags())
+}
+
 // getSeverityStyle returns the appropriate pterm style for a given severity level
 func getSeverityStyle(severity string) *pterm.Style {
 	switch severity {
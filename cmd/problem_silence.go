@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	problemSilenceFor      string
+	problemSilenceReason   string
+	problemSilenceWithTags bool
+)
+
+// maxMaintenanceNameLength is the maximum length of a maintenance name accepted by Zabbix.
+const maxMaintenanceNameLength = 128
+
+// errEventWithoutHost is returned when a problem to silence is not linked to any host.
+var errEventWithoutHost = errors.New("event is not linked to any host")
+
+// ProblemSilenceCmd represents the problem silence subcommand
+var ProblemSilenceCmd = &cobra.Command{
+	Use:   "silence <eventid>",
+	Short: "create a maintenance silencing a problem's host",
+	Long: `Create a one-time maintenance with data collection, starting now, scoped to the host(s)
+of the given problem event. With --with-tags, the maintenance is also restricted to the
+problem's tags, with equal values, so that other problems of the host keep alerting.
+
+The maintenance is named after the event ("Silence event <eventid>: <problem>") so that it
+is easy to find and remove later.
+
+Examples:
+  zabbix-cli problem silence 1234 --for 2h --reason "known issue, ticket OPS-42"
+  zabbix-cli problem silence 1234 --for 1d --with-tags`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		duration, err := parseDuration(problemSilenceFor)
+		if err != nil {
+			return err
+		}
+		if duration <= 0 {
+			return fmt.Errorf("--for must be a positive duration")
+		}
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		event, err := getEvent(ctx, z, args[0])
+		if err != nil {
+			return err
+		}
+		if len(event.Hosts) == 0 {
+			return fmt.Errorf("cannot silence event %s: %w", event.EventID, errEventWithoutHost)
+		}
+
+		now := time.Now()
+		options := silenceMaintenanceOptions(event, now, duration, problemSilenceReason, problemSilenceWithTags)
+		options = append(options,
+			zabbix.WithMaintenanceAuthToken(z.Auth()),
+			zabbix.WithMaintenanceRequestID(1),
+		)
+
+		response, err := z.MaintenanceCreate(ctx, zabbix.NewMaintenanceCreateRequest(options...))
+		if err != nil {
+			return fmt.Errorf("failed to create maintenance: %w", err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Maintenance created successfully.\n")
+		fmt.Fprintf(cmd.OutOrStdout(), "Maintenance IDs: %v\n", response.Result.MaintenanceIDs)
+		fmt.Fprintf(cmd.OutOrStdout(), "Name: %s\n", silenceMaintenanceName(event))
+		fmt.Fprintf(cmd.OutOrStdout(), "Hosts: %s\n", strings.Join(hostNames(event.Hosts), ", "))
+		fmt.Fprintf(cmd.OutOrStdout(), "Active until: %s\n", now.Add(duration).Format(time.RFC3339))
+		return nil
+	},
+}
+
+func init() {
+	ProblemSilenceCmd.Flags().StringVar(&problemSilenceFor, "for", "1h", "Duration of the silence (e.g., '90m', '2h', '1d')")
+	ProblemSilenceCmd.Flags().StringVar(&problemSilenceReason, "reason", "", "Reason of the silence, added to the maintenance description")
+	ProblemSilenceCmd.Flags().BoolVar(&problemSilenceWithTags, "with-tags", false, "Restrict the maintenance to the problem's tags")
+	ProblemCmd.AddCommand(ProblemSilenceCmd)
+}
+
+// getEvent returns the event with the given ID, with its hosts and tags.
+func getEvent(ctx context.Context, z *zabbix.Client, eventID string) (*zabbix.Event, error) {
+	response, err := z.EventGet(ctx, zabbix.NewEventGetRequest(
+		zabbix.WithEventGetEventIDs([]string{eventID}),
+		zabbix.WithEventGetSelectHosts([]string{"hostid", "name"}),
+		zabbix.WithEventGetSelectTags("extend"),
+		zabbix.WithEventGetAuth(z.Auth()),
+		zabbix.WithEventGetID(1),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event %s: %w", eventID, err)
+	}
+	if len(response.Result) == 0 {
+		return nil, fmt.Errorf("event %s: %w", eventID, zabbix.ErrEmptyResult)
+	}
+	return &response.Result[0], nil
+}
+
+// silenceMaintenanceName returns the name of the maintenance silencing an event.
+func silenceMaintenanceName(event *zabbix.Event) string {
+	name := fmt.Sprintf("Silence event %s: %s", event.EventID, event.Name)
+	if runes := []rune(name); len(runes) > maxMaintenanceNameLength {
+		name = string(runes[:maxMaintenanceNameLength])
+	}
+	return name
+}
+
+// silenceMaintenanceOptions returns the options of a one-time maintenance with data collection,
+// active from now for duration, scoped to the hosts (and optionally the tags) of event.
+func silenceMaintenanceOptions(event *zabbix.Event, now time.Time, duration time.Duration, reason string, withTags bool) []zabbix.MaintenanceCreateOption {
+	hostIDs := make([]string, 0, len(event.Hosts))
+	for _, h := range event.Hosts {
+		hostIDs = append(hostIDs, h.HostID)
+	}
+
+	description := fmt.Sprintf("Maintenance created by zabbix-cli on %s to silence event %s (%s)",
+		now.Format("2006-01-02 15:04:05"), event.EventID, event.Name)
+	if reason != "" {
+		description += "\nReason: " + reason
+	}
+
+	options := []zabbix.MaintenanceCreateOption{
+		zabbix.WithMaintenanceName(silenceMaintenanceName(event)),
+		zabbix.WithMaintenanceDescription(description),
+		zabbix.WithMaintenanceActiveSince(now.Unix()),
+		zabbix.WithMaintenanceActiveTill(now.Add(duration).Unix()),
+		zabbix.WithMaintenanceType(zabbix.MaintenanceWithDataCollection),
+		zabbix.WithMaintenanceTimePeriods([]zabbix.TimePeriod{
+			{
+				TimePeriodType: zabbix.TimePeriodTypeOneTime,
+				StartDate:      now.Unix(),
+				Period:         int(duration.Seconds()),
+			},
+		}),
+		zabbix.WithMaintenanceHostIDs(hostIDs),
+	}
+
+	if withTags && len(event.Tags) > 0 {
+		tags := make([]zabbix.ProblemTag, 0, len(event.Tags))
+		for _, t := range event.Tags {
+			// Match the exact tag values of the problem, not the ones containing them.
+			tags = append(tags, zabbix.ProblemTag{Tag: t.Tag, Value: t.Value, Operator: zabbix.MaintenanceTagOperatorEquals})
+		}
+		options = append(options,
+			zabbix.WithMaintenanceTags(tags),
+			zabbix.WithMaintenanceTagsEvalType(zabbix.TagsEvalTypeAnd),
+		)
+	}
+	return options
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSilenceMaintenanceOptions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	event := &zabbix.Event{
+		EventID: "42",
+		Name:    "High CPU",
+		Hosts:   []zabbix.HostInfo{{HostID: "10084", Name: "web01"}},
+		Tags:    []zabbix.ProblemResponseTag{{Tag: "service", Value: "nginx"}},
+	}
+
+	req := zabbix.NewMaintenanceCreateRequest(silenceMaintenanceOptions(event, now, 2*time.Hour, "ticket OPS-42", true)...)
+	assert.Equal(t, "Silence event 42: High CPU", req.Params.Name)
+	assert.Equal(t, []string{"10084"}, req.Params.HostIDs)
+	assert.Empty(t, req.Params.GroupIDs)
+	assert.Equal(t, zabbix.MaintenanceWithDataCollection, req.Params.MaintenanceType)
+	assert.Equal(t, now.Unix(), req.Params.ActiveSince.Int64())
+	assert.Equal(t, now.Add(2*time.Hour).Unix(), req.Params.ActiveTill.Int64())
+	assert.Equal(t, []zabbix.TimePeriod{{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: now.Unix(), Period: 7200}}, req.Params.TimePeriods)
+	assert.Equal(t, []zabbix.ProblemTag{{Tag: "service", Value: "nginx", Operator: zabbix.MaintenanceTagOperatorEquals}}, req.Params.Tags)
+	data, err := json.Marshal(req.Params.Tags)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"tag":"service","value":"nginx","operator":0}]`, string(data))
+	assert.Contains(t, req.Params.Description, "created by zabbix-cli")
+	assert.Contains(t, req.Params.Description, "Reason: ticket OPS-42")
+
+	req = zabbix.NewMaintenanceCreateRequest(silenceMaintenanceOptions(event, now, time.Hour, "", false)...)
+	assert.Empty(t, req.Params.Tags)
+}
+
+func TestSilenceMaintenanceNameTruncated(t *testing.T) {
+	t.Parallel()
+
+	event := &zabbix.Event{EventID: "1", Name: strings.Repeat("x", 200)}
+	assert.Len(t, silenceMaintenanceName(event), maxMaintenanceNameLength)
+}
@@ -0,0 +1,552 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	problemStreamStateFile string
+	problemStreamInterval  time.Duration
+	problemStreamBatch     int
+	problemStreamSeverity  string
+	problemStreamWindow    time.Duration
+)
+
+// Stream record types
+const (
+	streamTypeProblem  = "problem"
+	streamTypeUpdate   = "update"
+	streamTypeRecovery = "recovery"
+)
+
+// errStreamOutput is returned when a record or the cursor cannot be written. It stops the
+// stream, as retrying would lose or duplicate records.
+var errStreamOutput = errors.New("cannot write stream output")
+
+// streamState is the cursor persisted between runs of problem stream.
+type streamState struct {
+	LastEventID       string `json:"last_eventid"`
+	LastAcknowledgeID string `json:"last_acknowledgeid"`
+}
+
+// streamRecord is a single NDJSON line emitted by problem stream.
+type streamRecord struct {
+	Type           string                      `json:"type"`
+	EventID        string                      `json:"eventid"`
+	ProblemEventID string                      `json:"problem_eventid,omitempty"`
+	Clock          string                      `json:"clock"`
+	Name           string                      `json:"name,omitempty"`
+	Severity       string                      `json:"severity,omitempty"`
+	Hosts          []string                    `json:"hosts,omitempty"`
+	Tags           []zabbix.ProblemResponseTag `json:"tags,omitempty"`
+	AcknowledgeID  string                      `json:"acknowledgeid,omitempty"`
+	UserID         string                      `json:"userid,omitempty"`
+	Actions        []string                    `json:"actions,omitempty"`
+	Message        string                      `json:"message,omitempty"`
+	OldSeverity    string                      `json:"old_severity,omitempty"`
+	NewSeverity    string                      `json:"new_severity,omitempty"`
+}
+
+// ProblemStreamCmd represents the problem stream subcommand
+var ProblemStreamCmd = &cobra.Command{
+	Use:   "stream",
+	Short: "stream problem events as NDJSON",
+	Long: `Poll Zabbix for new problems, problem updates (acknowledgements, messages, severity changes)
+and recoveries, and print one JSON object per line on stdout.
+
+The position in the event stream is kept in a state file, so that a restarted stream continues
+where the previous one stopped. The state is saved after every emitted line. When the state file
+does not exist yet, the stream starts at the current position and does not replay history.
+
+Updates are streamed for all open problems, whatever their age, and for the problems of the last
+--updates-window (7 days by default), resolved or not. With --severity, problems and their
+updates are filtered on their severity, and recoveries on the severity of the problem they
+resolve. Recoveries of problems that no longer exist are skipped with a warning.
+
+The stream stops cleanly on SIGINT or SIGTERM, and with an error when stdout or the state file
+cannot be written.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		s := &problemStreamer{
+			z:             z,
+			out:           cmd.OutOrStdout(),
+			stateFile:     problemStreamStateFile,
+			batch:         problemStreamBatch,
+			updatesWindow: problemStreamWindow,
+		}
+		if problemStreamSeverity != "" {
+			severity, err := zabbix.ParseSeverity(problemStreamSeverity)
+			if err != nil {
+				return err
+			}
+			s.severities = []string{strconv.Itoa(int(severity))}
+		}
+
+		if err := s.init(ctx); err != nil {
+			return err
+		}
+
+		for {
+			if err := s.poll(ctx); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				if errors.Is(err, errStreamOutput) {
+					return err
+				}
+				fmt.Fprintf(os.Stderr, "Warning: poll failed: %v\n", err)
+				// The session may have expired, try to log in again before the next poll
+				if err := z.Login(ctx); err != nil && ctx.Err() == nil {
+					fmt.Fprintf(os.Stderr, "Warning: login failed: %v\n", err)
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(problemStreamInterval):
+			}
+		}
+	},
+}
+
+func init() {
+	ProblemStreamCmd.Flags().StringVar(&problemStreamStateFile, "state-file", defaultStreamStateFile(), "File used to persist the stream cursor between runs")
+	ProblemStreamCmd.Flags().DurationVar(&problemStreamInterval, "interval", 10*time.Second, "Polling interval")
+	ProblemStreamCmd.Flags().IntVar(&problemStreamBatch, "batch", 500, "Maximum number of events fetched per request")
+	ProblemStreamCmd.Flags().DurationVar(&problemStreamWindow, "updates-window", 7*24*time.Hour, "Age of the resolved problems whose updates are still streamed")
+	ProblemStreamCmd.Flags().StringVar(&problemStreamSeverity, "severity", "", "Only stream problems with this severity (e.g., 'Warning', 'High')")
+	ProblemCmd.AddCommand(ProblemStreamCmd)
+}
+
+// defaultStreamStateFile returns the default location of the stream state file.
+func defaultStreamStateFile() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "zabbix-cli-stream.json"
+	}
+	return filepath.Join(dir, "zabbix-cli", "stream.json")
+}
+
+// problemStreamer polls the Zabbix API and emits NDJSON records.
+type problemStreamer struct {
+	z             *zabbix.Client
+	out           io.Writer
+	stateFile     string
+	batch         int
+	severities    []string
+	updatesWindow time.Duration
+	state         streamState
+}
+
+// init loads the cursor from the state file, or positions it at the head of the
+// event stream when no state exists yet.
+func (s *problemStreamer) init(ctx context.Context) error {
+	data, err := os.ReadFile(s.stateFile)
+	if err == nil {
+		if err := json.Unmarshal(data, &s.state); err != nil {
+			return fmt.Errorf("cannot parse state file %s: %w", s.stateFile, err)
+		}
+		return nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("cannot read state file %s: %w", s.stateFile, err)
+	}
+
+	// No state yet: start from the most recent event and acknowledgement.
+	req := zabbix.NewEventGetRequest(
+		zabbix.WithEventGetAuth(s.z.Auth()),
+		zabbix.WithEventGetOutput([]string{"eventid"}),
+		zabbix.WithEventGetSortField([]string{"eventid"}),
+		zabbix.WithEventGetSortOrder([]string{"DESC"}),
+		zabbix.WithEventGetLimit(1),
+	)
+	resp, err := s.z.EventGet(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to get last event: %w", err)
+	}
+	s.state.LastEventID = "0"
+	if len(resp.Result) > 0 {
+		s.state.LastEventID = resp.Result[0].EventID
+	}
+
+	s.state.LastAcknowledgeID = "0"
+	err = s.scanUpdatedEvents(ctx, func(events []zabbix.Event) {
+		for _, ev := range events {
+			for _, ack := range ev.Acknowledges {
+				if idGreater(ack.AcknowledgeID, s.state.LastAcknowledgeID) {
+					s.state.LastAcknowledgeID = ack.AcknowledgeID
+				}
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "No state file found, starting after event %s\n", s.state.LastEventID)
+	return s.save()
+}
+
+// poll emits new problem and recovery events, then new problem updates.
+func (s *problemStreamer) poll(ctx context.Context) error {
+	if err := s.pollEvents(ctx); err != nil {
+		return err
+	}
+	return s.pollUpdates(ctx)
+}
+
+// matchesSeverity returns true if a problem with the given severity passes the --severity filter.
+func (s *problemStreamer) matchesSeverity(severity string) bool {
+	if len(s.severities) == 0 {
+		return true
+	}
+	for _, sev := range s.severities {
+		if sev == severity {
+			return true
+		}
+	}
+	return false
+}
+
+// pollEvents emits trigger events that are newer than the event cursor. The severity filter
+// is applied here rather than by event.get, as recovery events have no severity: a recovery is
+// emitted when the problem it resolves matches the filter.
+func (s *problemStreamer) pollEvents(ctx context.Context) error {
+	last, err := strconv.ParseUint(s.state.LastEventID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid event cursor %q: %w", s.state.LastEventID, err)
+	}
+
+	resp, err := s.z.EventGet(ctx, zabbix.NewEventGetRequest(
+		zabbix.WithEventGetAuth(s.z.Auth()),
+		zabbix.WithEventGetOutput("extend"),
+		zabbix.WithEventGetEventIDFrom(strconv.FormatUint(last+1, 10)),
+		zabbix.WithEventGetSelectHosts([]string{"hostid", "name"}),
+		zabbix.WithEventGetSelectTags("extend"),
+		zabbix.WithEventGetSortField([]string{"eventid"}),
+		zabbix.WithEventGetSortOrder([]string{"ASC"}),
+		zabbix.WithEventGetLimit(s.batch),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to get events: %w", err)
+	}
+
+	recoveredBy, err := s.problemsForRecoveries(ctx, resp.Result)
+	if err != nil {
+		return err
+	}
+
+	for _, ev := range resp.Result {
+		record := streamRecord{
+			Type:    streamTypeProblem,
+			EventID: ev.EventID,
+			Clock:   ev.GetClock().Format(time.RFC3339),
+			Name:    ev.Name,
+			Hosts:   hostNames(ev.Hosts),
+			Tags:    ev.Tags,
+		}
+		severity := ev.Severity
+		if !ev.IsProblem() {
+			problem, ok := recoveredBy[ev.EventID]
+			if !ok {
+				// The problem was deleted, e.g. by the housekeeper: the recovery cannot be tied to it.
+				fmt.Fprintf(os.Stderr, "Warning: problem of recovery event %s not found, skipped\n", ev.EventID)
+				s.state.LastEventID = ev.EventID
+				continue
+			}
+			record.Type = streamTypeRecovery
+			record.ProblemEventID = problem.EventID
+			severity = problem.Severity
+		}
+		if severity != "" {
+			record.Severity = severityName(severity)
+		}
+
+		if !s.matchesSeverity(severity) {
+			s.state.LastEventID = ev.EventID
+			continue
+		}
+		if err := s.emit(record, func() { s.state.LastEventID = ev.EventID }); err != nil {
+			return err
+		}
+	}
+	if err := s.save(); err != nil {
+		return fmt.Errorf("%w: %w", errStreamOutput, err)
+	}
+	return nil
+}
+
+// problemsForRecoveries maps recovery event IDs to the problem events they resolved. The
+// problem events of the recovered triggers are read backwards from the last recovery, page by
+// page, until every recovery is mapped.
+func (s *problemStreamer) problemsForRecoveries(ctx context.Context, events []zabbix.Event) (map[string]zabbix.Event, error) {
+	recoveredBy := map[string]zabbix.Event{}
+	pending := map[string]bool{}
+	var triggerIDs []string
+	till := ""
+	for _, ev := range events {
+		if !ev.IsProblem() {
+			pending[ev.EventID] = true
+			triggerIDs = append(triggerIDs, ev.ObjectID)
+			till = ev.EventID
+		}
+	}
+
+	for len(pending) > 0 {
+		resp, err := s.z.EventGet(ctx, zabbix.NewEventGetRequest(
+			zabbix.WithEventGetAuth(s.z.Auth()),
+			zabbix.WithEventGetOutput([]string{"eventid", "r_eventid", "severity", "name"}),
+			zabbix.WithEventGetObjectIDs(uniqueSorted(triggerIDs)),
+			zabbix.WithEventGetValue([]string{zabbix.EventValueProblem}),
+			zabbix.WithEventGetEventIDTill(till),
+			zabbix.WithEventGetSortField([]string{"eventid"}),
+			zabbix.WithEventGetSortOrder([]string{"DESC"}),
+			zabbix.WithEventGetLimit(s.batch),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get recovered problems: %w", err)
+		}
+		for _, pb := range resp.Result {
+			if pending[pb.ReventID] {
+				recoveredBy[pb.ReventID] = pb
+				delete(pending, pb.ReventID)
+			}
+		}
+		if len(resp.Result) < s.batch {
+			break
+		}
+		last, err := strconv.ParseUint(resp.Result[len(resp.Result)-1].EventID, 10, 64)
+		if err != nil || last == 0 {
+			break
+		}
+		till = strconv.FormatUint(last-1, 10)
+	}
+	return recoveredBy, nil
+}
+
+// scanUpdatedEvents calls fn with the problem events that can receive updates, page by page: the
+// problem events of the last --updates-window, which covers updates of resolved problems, then the
+// ones of the open problems older than the window. Every request is bounded by --batch.
+func (s *problemStreamer) scanUpdatedEvents(ctx context.Context, fn func([]zabbix.Event)) error {
+	options := []zabbix.EventGetOption{
+		zabbix.WithEventGetAuth(s.z.Auth()),
+		zabbix.WithEventGetOutput("extend"),
+		zabbix.WithEventGetValue([]string{zabbix.EventValueProblem}),
+		zabbix.WithEventGetSelectAcknowledges("extend"),
+		zabbix.WithEventGetSelectHosts([]string{"hostid", "name"}),
+		zabbix.WithEventGetSortField([]string{"eventid"}),
+		zabbix.WithEventGetSortOrder([]string{"ASC"}),
+		zabbix.WithEventGetLimit(s.batch),
+	}
+	timeFrom := time.Now().Add(-s.updatesWindow).Unix()
+	seen := map[string]bool{}
+	from := ""
+	for {
+		pageOptions := append(options[:len(options):len(options)], zabbix.WithEventGetTimeFrom(timeFrom))
+		if from != "" {
+			pageOptions = append(pageOptions, zabbix.WithEventGetEventIDFrom(from))
+		}
+		resp, err := s.z.EventGet(ctx, zabbix.NewEventGetRequest(pageOptions...))
+		if err != nil {
+			return fmt.Errorf("failed to get problem updates: %w", err)
+		}
+		if len(resp.Result) == 0 {
+			break
+		}
+		for _, ev := range resp.Result {
+			seen[ev.EventID] = true
+		}
+		fn(resp.Result)
+		if len(resp.Result) < s.batch {
+			break
+		}
+		if from, err = nextEventID(resp.Result[len(resp.Result)-1].EventID); err != nil {
+			return err
+		}
+	}
+
+	// Open problems older than the window.
+	from = ""
+	for {
+		problemOptions := []zabbix.GetProblemOption{
+			zabbix.GetProblemOptionOutput([]string{"eventid"}),
+			zabbix.GetProblemOptionSortField([]string{"eventid"}),
+			zabbix.GetProblemOptionSortOrder([]string{"ASC"}),
+			zabbix.GetProblemOptionLimit(s.batch),
+		}
+		if from != "" {
+			problemOptions = append(problemOptions, zabbix.GetProblemOptionEventidFrom(from))
+		}
+		problems, err := s.z.GetProblems(ctx, problemOptions...)
+		if err != nil {
+			return fmt.Errorf("failed to get problems: %w", err)
+		}
+		var older []string
+		for _, pb := range problems {
+			if !seen[pb.EventID] {
+				older = append(older, pb.EventID)
+			}
+		}
+		if len(older) > 0 {
+			resp, err := s.z.EventGet(ctx, zabbix.NewEventGetRequest(append(options, zabbix.WithEventGetEventIDs(older))...))
+			if err != nil {
+				return fmt.Errorf("failed to get problem updates: %w", err)
+			}
+			fn(resp.Result)
+		}
+		if len(problems) < s.batch {
+			break
+		}
+		if from, err = nextEventID(problems[len(problems)-1].EventID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextEventID returns the event ID following id, to read the next page of a query sorted by event ID.
+func nextEventID(id string) (string, error) {
+	last, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid event ID %q: %w", id, err)
+	}
+	return strconv.FormatUint(last+1, 10), nil
+}
+
+// pollUpdates emits problem updates that are newer than the acknowledge cursor.
+func (s *problemStreamer) pollUpdates(ctx context.Context) error {
+	// Only the events with new updates are kept while scanning.
+	type update struct {
+		event *zabbix.Event
+		ack   zabbix.AcknowledgeEntry
+	}
+	var updates []update
+	err := s.scanUpdatedEvents(ctx, func(events []zabbix.Event) {
+		for i := range events {
+			if !s.matchesSeverity(events[i].Severity) {
+				continue
+			}
+			for _, ack := range events[i].Acknowledges {
+				if idGreater(ack.AcknowledgeID, s.state.LastAcknowledgeID) {
+					updates = append(updates, update{event: &events[i], ack: ack})
+				}
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(updates, func(i, j int) bool {
+		return idGreater(updates[j].ack.AcknowledgeID, updates[i].ack.AcknowledgeID)
+	})
+
+	for _, u := range updates {
+		record := streamRecord{
+			Type:           streamTypeUpdate,
+			EventID:        u.ack.EventID,
+			ProblemEventID: u.event.EventID,
+			Clock:          time.Unix(u.ack.Clock.Int64(), 0).Format(time.RFC3339),
+			Name:           u.event.Name,
+			Severity:       severityName(u.event.Severity),
+			Hosts:          hostNames(u.event.Hosts),
+			AcknowledgeID:  u.ack.AcknowledgeID,
+			UserID:         u.ack.UserID,
+			Message:        u.ack.Message,
+		}
+		for _, action := range zabbix.RetrieveActions(zabbix.EventAction(u.ack.Action.Int64())) {
+			record.Actions = append(record.Actions, action.String())
+		}
+		if zabbix.EventAction(u.ack.Action.Int64())&zabbix.ChangeSeverity != 0 {
+			record.OldSeverity = zabbix.NewSeverity(int(u.ack.OldSeverity.Int64())).String()
+			record.NewSeverity = zabbix.NewSeverity(int(u.ack.NewSeverity.Int64())).String()
+		}
+		if err := s.emit(record, func() { s.state.LastAcknowledgeID = u.ack.AcknowledgeID }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// emit writes a record as a single JSON line, then advances the cursor past it and persists it.
+// The cursor is left unchanged when the record cannot be written.
+func (s *problemStreamer) emit(record streamRecord, advance func()) error {
+	if err := json.NewEncoder(s.out).Encode(record); err != nil {
+		return fmt.Errorf("%w: cannot write record: %w", errStreamOutput, err)
+	}
+	advance()
+	if err := s.save(); err != nil {
+		return fmt.Errorf("%w: %w", errStreamOutput, err)
+	}
+	return nil
+}
+
+// save atomically writes the cursor to the state file.
+func (s *problemStreamer) save() error {
+	data, err := json.Marshal(s.state)
+	if err != nil {
+		return fmt.Errorf("cannot marshal state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.stateFile), 0o755); err != nil {
+		return fmt.Errorf("cannot create state directory: %w", err)
+	}
+	tmp := s.stateFile + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("cannot write state file: %w", err)
+	}
+	if err := os.Rename(tmp, s.stateFile); err != nil {
+		return fmt.Errorf("cannot write state file: %w", err)
+	}
+	return nil
+}
+
+// idGreater compares two numeric Zabbix IDs.
+func idGreater(a, b string) bool {
+	ai, errA := strconv.ParseUint(a, 10, 64)
+	bi, errB := strconv.ParseUint(b, 10, 64)
+	if errA != nil || errB != nil {
+		return a > b
+	}
+	return ai > bi
+}
+
+// hostNames returns the visible names of the given hosts.
+func hostNames(hosts []zabbix.HostInfo) []string {
+	names := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		names = append(names, h.Name)
+	}
+	return names
+}
+
+// severityName converts a numeric severity string to its name.
+func severityName(severity string) string {
+	s, err := strconv.Atoi(severity)
+	if err != nil {
+		return "Unknown"
+	}
+	return zabbix.NewSeverity(s).String()
+}
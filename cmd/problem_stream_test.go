@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newStreamTestServer returns a Zabbix API stub for problem stream. Event 100 is a High problem
+// resolved by event 203, event 201 a Warning problem resolved by event 204, event 202 an open
+// High problem and event 90 an open High problem older than the updates window. Acknowledgements
+// 60 (severity change) and 61 (message) are on events 100 and 90.
+func newStreamTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	old := time.Now().Add(-30 * 24 * time.Hour).Unix()
+	recent := time.Now().Add(-time.Hour).Unix()
+	event := func(id, value, severity, rEventID, acks string, clock int64) string {
+		return fmt.Sprintf(`{"eventid":%q,"objectid":"1%s","clock":"%d","name":"Problem %s","value":%q,"severity":%q,
+			"r_eventid":%q,"hosts":[{"hostid":"1","name":"web01"}],"acknowledges":[%s]}`,
+			id, id, clock, id, value, severity, rEventID, acks)
+	}
+	ack60 := `{"acknowledgeid":"60","userid":"1","eventid":"100","clock":"1760000000","message":"raised",
+		"action":"12","old_severity":"3","new_severity":"4"}`
+	ack50 := `{"acknowledgeid":"50","userid":"1","eventid":"100","clock":"1750000000","message":"","action":"2",
+		"old_severity":"0","new_severity":"0"}`
+	ack61 := `{"acknowledgeid":"61","userid":"2","eventid":"90","clock":"1760000100","message":"still there",
+		"action":"4","old_severity":"0","new_severity":"0"}`
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string         `json:"method"`
+			Params map[string]any `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.NotContains(t, req.Params, "severities")
+
+		var events []string
+		switch {
+		case req.Method == "problem.get":
+			events = []string{`{"eventid":"90"}`, `{"eventid":"202"}`}
+		case req.Method != "event.get":
+			t.Errorf("unexpected method %s", req.Method)
+		case req.Params["limit"] == float64(1):
+			events = []string{`{"eventid":"204"}`}
+		case req.Params["eventid_from"] != nil:
+			require.Equal(t, "201", req.Params["eventid_from"])
+			events = []string{
+				event("201", "1", "2", "204", "", recent),
+				event("202", "1", "4", "0", "", recent),
+				event("203", "0", "0", "0", "", recent),
+				event("204", "0", "0", "0", "", recent),
+			}
+		case req.Params["objectids"] != nil:
+			assert.Equal(t, "204", req.Params["eventid_till"])
+			events = []string{event("201", "1", "2", "204", "", recent), event("100", "1", "4", "203", ack50+","+ack60, recent)}
+		case req.Params["eventids"] != nil:
+			assert.Equal(t, []any{"90"}, req.Params["eventids"])
+			events = []string{event("90", "1", "4", "0", ack61, old)}
+		case req.Params["time_from"] != nil:
+			events = []string{
+				event("100", "1", "4", "203", ack50+","+ack60, recent),
+				event("201", "1", "2", "204", "", recent),
+				event("202", "1", "4", "0", "", recent),
+			}
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","result":[%s],"id":1}`, strings.Join(events, ","))
+	}))
+}
+
+// newTestStreamer returns a streamer on the stub server, writing its state in a temporary directory.
+func newTestStreamer(t *testing.T, ts *httptest.Server, out *bytes.Buffer) *problemStreamer {
+	t.Helper()
+	client := zabbix.New("user", "pass", ts.URL)
+	return &problemStreamer{
+		z:             &client,
+		out:           out,
+		stateFile:     filepath.Join(t.TempDir(), "state", "stream.json"),
+		batch:         500,
+		updatesWindow: 7 * 24 * time.Hour,
+	}
+}
+
+func readStreamState(t *testing.T, path string) streamState {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var state streamState
+	require.NoError(t, json.Unmarshal(data, &state))
+	return state
+}
+
+func TestProblemStreamInitWithoutState(t *testing.T) {
+	t.Parallel()
+	ts := newStreamTestServer(t)
+	defer ts.Close()
+
+	var out bytes.Buffer
+	s := newTestStreamer(t, ts, &out)
+	require.NoError(t, s.init(context.Background()))
+	assert.Equal(t, streamState{LastEventID: "204", LastAcknowledgeID: "61"}, s.state)
+	assert.Equal(t, s.state, readStreamState(t, s.stateFile))
+	assert.Empty(t, out.String())
+}
+
+func TestProblemStreamInitWithState(t *testing.T) {
+	t.Parallel()
+	ts := newStreamTestServer(t)
+	defer ts.Close()
+
+	var out bytes.Buffer
+	s := newTestStreamer(t, ts, &out)
+	require.NoError(t, os.MkdirAll(filepath.Dir(s.stateFile), 0o755))
+	require.NoError(t, os.WriteFile(s.stateFile, []byte(`{"last_eventid":"200","last_acknowledgeid":"55"}`), 0o600))
+	require.NoError(t, s.init(context.Background()))
+	assert.Equal(t, streamState{LastEventID: "200", LastAcknowledgeID: "55"}, s.state)
+}
+
+func TestProblemStreamPoll(t *testing.T) {
+	t.Parallel()
+	ts := newStreamTestServer(t)
+	defer ts.Close()
+
+	var out bytes.Buffer
+	s := newTestStreamer(t, ts, &out)
+	s.severities = []string{strconv.Itoa(int(zabbix.High))}
+	s.state = streamState{LastEventID: "200", LastAcknowledgeID: "55"}
+	require.NoError(t, s.poll(context.Background()))
+
+	var records []streamRecord
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var record streamRecord
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	require.Len(t, records, 4)
+
+	// The Warning problem 201 and its recovery 204 are filtered out.
+	assert.Equal(t, streamTypeProblem, records[0].Type)
+	assert.Equal(t, "202", records[0].EventID)
+	assert.Equal(t, "High", records[0].Severity)
+
+	// The recovery has no severity: it is filtered on the severity of the problem it resolves.
+	assert.Equal(t, streamTypeRecovery, records[1].Type)
+	assert.Equal(t, "203", records[1].EventID)
+	assert.Equal(t, "100", records[1].ProblemEventID)
+	assert.Equal(t, "High", records[1].Severity)
+
+	assert.Equal(t, streamTypeUpdate, records[2].Type)
+	assert.Equal(t, "60", records[2].AcknowledgeID)
+	assert.Equal(t, "100", records[2].ProblemEventID)
+	assert.Equal(t, "Average", records[2].OldSeverity)
+	assert.Equal(t, "High", records[2].NewSeverity)
+	assert.Equal(t, "raised", records[2].Message)
+
+	// The update of the open problem older than the updates window is streamed.
+	assert.Equal(t, streamTypeUpdate, records[3].Type)
+	assert.Equal(t, "61", records[3].AcknowledgeID)
+	assert.Equal(t, "90", records[3].ProblemEventID)
+	assert.Equal(t, "still there", records[3].Message)
+
+	assert.Equal(t, streamState{LastEventID: "204", LastAcknowledgeID: "61"}, readStreamState(t, s.stateFile))
+}
+
+// failingWriter fails every write.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, os.ErrClosed }
+
+func TestProblemStreamEmitFailure(t *testing.T) {
+	t.Parallel()
+	ts := newStreamTestServer(t)
+	defer ts.Close()
+
+	s := newTestStreamer(t, ts, nil)
+	s.out = failingWriter{}
+	s.state = streamState{LastEventID: "200", LastAcknowledgeID: "55"}
+	err := s.poll(context.Background())
+	require.ErrorIs(t, err, errStreamOutput)
+
+	// The cursor does not move past the first record, which was not written.
+	assert.Equal(t, streamState{LastEventID: "200", LastAcknowledgeID: "55"}, s.state)
+	assert.NoFileExists(t, s.stateFile)
+}
+
+// newStreamPagingTestServer returns a Zabbix API stub serving pages of two results. Events 100 to
+// 102 are in the updates window, open problems 90 and 95 are older, and recovery event 300 resolves
+// a problem that no longer exists.
+func newStreamPagingTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	events := func(ids ...string) string {
+		var list []string
+		for _, id := range ids {
+			list = append(list, fmt.Sprintf(`{"eventid":%q,"objectid":"1","value":"1","severity":"4"}`, id))
+		}
+		return strings.Join(list, ",")
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string         `json:"method"`
+			Params map[string]any `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result string
+		switch {
+		case req.Params["eventids"] != nil:
+			var ids []string
+			for _, id := range req.Params["eventids"].([]any) {
+				ids = append(ids, id.(string))
+			}
+			result = events(ids...)
+		case req.Params["objectids"] != nil:
+		case req.Method == "problem.get":
+			assert.Equal(t, float64(2), req.Params["limit"])
+			if req.Params["eventid_from"] == nil {
+				result = `{"eventid":"90"},{"eventid":"95"}`
+			} else {
+				assert.Equal(t, "96", req.Params["eventid_from"])
+				result = `{"eventid":"102"}`
+			}
+		case req.Params["time_from"] != nil:
+			assert.Equal(t, float64(2), req.Params["limit"])
+			if req.Params["eventid_from"] == nil {
+				result = events("100", "101")
+			} else {
+				assert.Equal(t, "102", req.Params["eventid_from"])
+				result = events("102")
+			}
+		case req.Params["eventid_from"] != nil:
+			result = `{"eventid":"300","objectid":"1","value":"0","severity":"0"}`
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","result":[%s],"id":1}`, result)
+	}))
+}
+
+func TestProblemStreamScanUpdatedEventsPaged(t *testing.T) {
+	t.Parallel()
+	ts := newStreamPagingTestServer(t)
+	defer ts.Close()
+
+	s := newTestStreamer(t, ts, nil)
+	s.batch = 2
+	var ids []string
+	require.NoError(t, s.scanUpdatedEvents(context.Background(), func(events []zabbix.Event) {
+		assert.LessOrEqual(t, len(events), 2)
+		for _, ev := range events {
+			ids = append(ids, ev.EventID)
+		}
+	}))
+	assert.Equal(t, []string{"100", "101", "102", "90", "95"}, ids)
+}
+
+func TestProblemStreamSkipsRecoveryOfUnknownProblem(t *testing.T) {
+	t.Parallel()
+	ts := newStreamPagingTestServer(t)
+	defer ts.Close()
+
+	var out bytes.Buffer
+	s := newTestStreamer(t, ts, &out)
+	s.state = streamState{LastEventID: "299", LastAcknowledgeID: "0"}
+	require.NoError(t, s.pollEvents(context.Background()))
+	assert.Empty(t, out.String())
+	assert.Equal(t, "300", readStreamState(t, s.stateFile).LastEventID)
+}
@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var problemTriageFilters problemFilters
+
+// Labels of the actions offered by the interactive triage.
+const (
+	triageActionAck      = "Acknowledge"
+	triageActionMessage  = "Add message"
+	triageActionClose    = "Close"
+	triageActionSeverity = "Change severity"
+	triageActionSuppress = "Suppress"
+)
+
+// ProblemTriageCmd represents the problem triage subcommand
+var ProblemTriageCmd = &cobra.Command{
+	Use:   "triage",
+	Short: "interactively pick problems and update them",
+	Long: `Fetch problems with the usual filters, pick some of them in an interactive list
+(type to fuzzy search on host and problem name), then choose the actions to apply:
+acknowledge, add a message, close, change severity or suppress.
+
+All the selected problems are updated with a single event.acknowledge call.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		// The problems and the update are already chosen interactively: no further confirmation.
+		return runProblemTriage(ctx, cmd, z, &problemTriageFilters, nil, triageOptions{yes: true})
+	},
+}
+
+func init() {
+	problemTriageFilters.addFlags(ProblemTriageCmd.Flags())
+	ProblemCmd.AddCommand(ProblemTriageCmd)
+}
+
+// triageOptions tunes the interactive triage when it is started from another command.
+type triageOptions struct {
+	// defaultActions are preselected in the action menu.
+	defaultActions []string
+	// message is used for the "Add message" action instead of prompting for it when not empty.
+	message string
+	// dryRun shows the update that would be applied to the selected problems without applying it.
+	dryRun bool
+	// yes skips the confirmation.
+	yes bool
+	// confirmThreshold asks for a confirmation when more problems are selected, as needsConfirmation.
+	confirmThreshold int
+}
+
+// runProblemTriage fetches the problems matching filters (and eventIDs if not empty),
+// lets the user pick some of them and the actions to apply, then applies the actions.
+func runProblemTriage(ctx context.Context, cmd *cobra.Command, z *zabbix.Client, filters *problemFilters, eventIDs []string, opts triageOptions) error {
+	options, err := filters.options(ctx, z)
+	if err != nil {
+		return err
+	}
+	if len(eventIDs) > 0 {
+		options = append(options, zabbix.GetProblemOptionEventIDs(eventIDs))
+	}
+	options = append(options, zabbix.GetProblemOptionSelectHosts("extend"))
+
+	problems, err := z.GetProblems(ctx, options...)
+	if err != nil {
+		return fmt.Errorf("failed to get problems: %w", err)
+	}
+	if len(problems) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No problem matches the filters")
+		return nil
+	}
+
+	selected, err := pickProblems(problems)
+	if err != nil {
+		return err
+	}
+	if len(selected) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No problem selected")
+		return nil
+	}
+
+	update, err := promptEventUpdate(opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.dryRun {
+		fmt.Fprintf(cmd.OutOrStdout(), "Dry run: %d problem(s) would be updated: %s\n", len(selected), update.describe())
+		return nil
+	}
+	if needsConfirmation(len(selected), opts.confirmThreshold, opts.yes) {
+		if !confirm(cmd.InOrStdin(), cmd.OutOrStdout(), fmt.Sprintf("Update %d problems (%s)?", len(selected), update.describe())) {
+			return fmt.Errorf("aborted by user")
+		}
+	}
+
+	ids, err := update.apply(ctx, z, selected)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Updated %d problem(s): %s\n", len(ids), update.describe())
+	return nil
+}
+
+// pickProblems shows an interactive multiselect of problems and returns the selected event IDs.
+func pickProblems(problems []zabbix.Problem) ([]string, error) {
+	labels := make([]string, 0, len(problems))
+	eventIDs := make(map[string]string, len(problems))
+	for i := range problems {
+		label := problemLabel(&problems[i])
+		labels = append(labels, label)
+		eventIDs[label] = problems[i].EventID
+	}
+
+	chosen, err := pterm.DefaultInteractiveMultiselect.
+		WithOptions(labels).
+		WithFilter(true).
+		WithMaxHeight(15).
+		WithDefaultText("Select problems (type to search, enter to toggle, tab to confirm)").
+		Show()
+	if err != nil {
+		return nil, fmt.Errorf("problem selection failed: %w", err)
+	}
+
+	selected := make([]string, 0, len(chosen))
+	for _, label := range chosen {
+		selected = append(selected, eventIDs[label])
+	}
+	return selected, nil
+}
+
+// problemLabel returns the label of a problem in the interactive list.
+// The event ID makes every label unique.
+func problemLabel(pb *zabbix.Problem) string {
+	host := "N/A"
+	if len(pb.Hosts) > 0 {
+		host = pb.Hosts[0].Name
+	}
+	return fmt.Sprintf("%s | %s | %s | %s | #%s", host, pb.Name, pb.GetSeverity(), pb.GetDurationStr(), pb.EventID)
+}
+
+// promptEventUpdate asks for the actions to apply and their parameters.
+func promptEventUpdate(opts triageOptions) (*eventUpdate, error) {
+	chosen, err := pterm.DefaultInteractiveMultiselect.
+		WithOptions([]string{triageActionAck, triageActionMessage, triageActionClose, triageActionSeverity, triageActionSuppress}).
+		WithDefaultOptions(opts.defaultActions).
+		WithDefaultText("Select actions (enter to toggle, tab to confirm)").
+		Show()
+	if err != nil {
+		return nil, fmt.Errorf("action selection failed: %w", err)
+	}
+
+	inputs := triageInputs{
+		message:       promptMessage,
+		severity:      promptSeverity,
+		suppressUntil: promptSuppressUntil,
+	}
+	if opts.message != "" {
+		inputs.message = func() (string, error) { return opts.message, nil }
+	}
+	return buildEventUpdate(chosen, inputs)
+}
+
+// triageInputs reads the parameters of the actions chosen in the triage.
+type triageInputs struct {
+	message       func() (string, error)
+	severity      func() (zabbix.Severity, error)
+	suppressUntil func() (int64, error)
+}
+
+// buildEventUpdate builds the update for the chosen action labels, reading their parameters from inputs.
+func buildEventUpdate(chosen []string, inputs triageInputs) (*eventUpdate, error) {
+	update := &eventUpdate{}
+	for _, action := range chosen {
+		switch action {
+		case triageActionAck:
+			update.actions = append(update.actions, zabbix.Acknowledge)
+		case triageActionClose:
+			update.actions = append(update.actions, zabbix.CloseProblem)
+		case triageActionMessage:
+			message, err := inputs.message()
+			if err != nil {
+				return nil, err
+			}
+			update.message = message
+			update.actions = append(update.actions, zabbix.AddMessage)
+		case triageActionSeverity:
+			severity, err := inputs.severity()
+			if err != nil {
+				return nil, err
+			}
+			update.severity = severity
+			update.actions = append(update.actions, zabbix.ChangeSeverity)
+		case triageActionSuppress:
+			until, err := inputs.suppressUntil()
+			if err != nil {
+				return nil, err
+			}
+			update.suppressUntil = until
+			update.actions = append(update.actions, zabbix.Suppress)
+		}
+	}
+	if len(update.actions) == 0 {
+		return nil, errNoEventAction
+	}
+	return update, nil
+}
+
+// promptMessage asks for the message to add.
+func promptMessage() (string, error) {
+	message, err := pterm.DefaultInteractiveTextInput.Show("Message")
+	if err != nil {
+		return "", fmt.Errorf("message input failed: %w", err)
+	}
+	return message, nil
+}
+
+// promptSuppressUntil asks for the end of the suppression.
+func promptSuppressUntil() (int64, error) {
+	value, err := pterm.DefaultInteractiveTextInput.
+		WithDefaultValue("2h").
+		Show("Suppress until (time, duration or 'indefinitely')")
+	if err != nil {
+		return 0, fmt.Errorf("suppression input failed: %w", err)
+	}
+	return parseSuppressUntil(value, time.Now())
+}
+
+// promptSeverity asks for a new severity.
+func promptSeverity() (zabbix.Severity, error) {
+	names := make([]string, 0, int(zabbix.Disaster)+1)
+	for s := zabbix.NotClassified; s <= zabbix.Disaster; s++ {
+		names = append(names, s.String())
+	}
+	chosen, err := pterm.DefaultInteractiveSelect.
+		WithOptions(names).
+		WithDefaultText("New severity").
+		Show()
+	if err != nil {
+		return zabbix.NotClassified, fmt.Errorf("severity selection failed: %w", err)
+	}
+	return zabbix.ParseSeverity(chosen)
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedTriageInputs returns inputs answering the given values without prompting.
+func fixedTriageInputs(message string, severity zabbix.Severity, until int64) triageInputs {
+	return triageInputs{
+		message:       func() (string, error) { return message, nil },
+		severity:      func() (zabbix.Severity, error) { return severity, nil },
+		suppressUntil: func() (int64, error) { return until, nil },
+	}
+}
+
+func TestBuildEventUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		chosen []string
+		action zabbix.EventAction
+		want   eventUpdate
+	}{
+		{
+			name:   "acknowledge",
+			chosen: []string{triageActionAck},
+			action: zabbix.Acknowledge,
+			want:   eventUpdate{actions: []zabbix.EventAction{zabbix.Acknowledge}},
+		},
+		{
+			name:   "acknowledge with message and close",
+			chosen: []string{triageActionAck, triageActionMessage, triageActionClose},
+			action: zabbix.Acknowledge | zabbix.AddMessage | zabbix.CloseProblem,
+			want: eventUpdate{
+				actions: []zabbix.EventAction{zabbix.Acknowledge, zabbix.AddMessage, zabbix.CloseProblem},
+				message: "on it",
+			},
+		},
+		{
+			name:   "severity and suppression",
+			chosen: []string{triageActionSeverity, triageActionSuppress},
+			action: zabbix.ChangeSeverity | zabbix.Suppress,
+			want: eventUpdate{
+				actions:       []zabbix.EventAction{zabbix.ChangeSeverity, zabbix.Suppress},
+				severity:      zabbix.Disaster,
+				suppressUntil: 1_800_000_000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			update, err := buildEventUpdate(tt.chosen, fixedTriageInputs("on it", zabbix.Disaster, 1_800_000_000))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, *update)
+			assert.Equal(t, tt.action, update.action())
+
+			req := &zabbix.EventAcknowledgeRequest{}
+			for _, opt := range update.options() {
+				opt(req)
+			}
+			assert.Equal(t, int(tt.action), req.Params.Action)
+			assert.Equal(t, tt.want.message, req.Params.Message)
+			assert.Equal(t, tt.want.severity, req.Params.Severity)
+			assert.Equal(t, tt.want.suppressUntil, req.Params.SuppressUntil)
+		})
+	}
+}
+
+func TestBuildEventUpdateErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := buildEventUpdate(nil, fixedTriageInputs("", zabbix.NotClassified, 0))
+	require.ErrorIs(t, err, errNoEventAction)
+
+	errInput := errors.New("input failed")
+	inputs := fixedTriageInputs("", zabbix.NotClassified, 0)
+	inputs.severity = func() (zabbix.Severity, error) { return zabbix.NotClassified, errInput }
+	_, err = buildEventUpdate([]string{triageActionAck, triageActionSeverity}, inputs)
+	require.ErrorIs(t, err, errInput)
+}
+
+func TestAckTriageDefaults(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{triageActionAck}, ackTriageDefaults("", false))
+	assert.Equal(t, []string{triageActionAck, triageActionMessage}, ackTriageDefaults("on it", false))
+	assert.Equal(t, []string{triageActionAck, triageActionMessage, triageActionClose}, ackTriageDefaults("on it", true))
+}
@@ -0,0 +1,360 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+)
+
+// errNotFound is returned when host or host group names cannot be resolved.
+var errNotFound = errors.New("not found")
+
+// isGlob returns true if the pattern contains a Zabbix search wildcard.
+func isGlob(pattern string) bool {
+	return strings.Contains(pattern, "*")
+}
+
+// globMatch reports whether s matches pattern, where '*' matches any sequence of characters.
+// Like Zabbix searches, the match is case-insensitive.
+func globMatch(pattern, s string) bool {
+	parts := strings.Split(strings.ToLower(pattern), "*")
+	s = strings.ToLower(s)
+	if len(parts) == 1 {
+		return s == parts[0]
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return strings.HasSuffix(s, last)
+}
+
+// splitGlobs splits patterns into exact names and wildcard patterns.
+func splitGlobs(patterns []string) ([]string, []string) {
+	var names, globs []string
+	for _, p := range patterns {
+		if isGlob(p) {
+			globs = append(globs, p)
+		} else {
+			names = append(names, p)
+		}
+	}
+	return names, globs
+}
+
+// resolveHosts returns the hosts matching the given names or globs ('*' wildcard).
+// Exact names are matched against the technical name, then the visible name.
+// Every name and glob must match at least one host.
+func resolveHosts(ctx context.Context, z *zabbix.Client, patterns []string) ([]zabbix.Host, error) {
+	names, globs := splitGlobs(patterns)
+	output := []string{"hostid", "host", "name", "status"}
+	found := make(map[string]zabbix.Host)
+	var hosts []zabbix.Host
+	add := func(result []zabbix.Host) {
+		for _, h := range result {
+			if _, ok := found[h.HostID]; !ok {
+				hosts = append(hosts, h)
+			}
+			found[h.HostID] = h
+		}
+	}
+
+	if len(names) > 0 {
+		for _, field := range []string{"host", "name"} {
+			response, err := z.HostGet(ctx, zabbix.NewHostGetRequest(
+				zabbix.WithHostGetOutput(output),
+				zabbix.WithHostGetFilter(map[string]any{field: names}),
+				zabbix.WithHostGetAuth(z.Auth()),
+				zabbix.WithHostGetID(1),
+			))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get hosts: %w", err)
+			}
+			add(response.Result)
+		}
+	}
+
+	var missing []string
+	for _, name := range names {
+		if !hostMatchesName(hosts, name) {
+			missing = append(missing, name)
+		}
+	}
+
+	for _, glob := range globs {
+		response, err := z.HostGet(ctx, zabbix.NewHostGetRequest(
+			zabbix.WithHostGetOutput(output),
+			zabbix.WithHostGetSearch(map[string]any{"host": glob, "name": glob}),
+			zabbix.WithHostGetSearchByAny(true),
+			zabbix.WithHostGetSearchWildcardsEnabled(true),
+			zabbix.WithHostGetAuth(z.Auth()),
+			zabbix.WithHostGetID(1),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get hosts: %w", err)
+		}
+		if len(response.Result) == 0 {
+			missing = append(missing, glob)
+		}
+		add(response.Result)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("host(s) %s: %w", strings.Join(missing, ", "), errNotFound)
+	}
+	return hosts, nil
+}
+
+// hostMatchesName returns true if one of hosts has the given technical or visible name.
+func hostMatchesName(hosts []zabbix.Host, name string) bool {
+	for _, h := range hosts {
+		if h.Host == name || h.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveHostIDs returns the IDs of the hosts matching the given names or globs.
+func resolveHostIDs(ctx context.Context, z *zabbix.Client, patterns []string) ([]string, error) {
+	hosts, err := resolveHosts(ctx, z, patterns)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		ids = append(ids, h.HostID)
+	}
+	return ids, nil
+}
+
+// resolveHostGroups returns the host groups matching the given names or globs ('*' wildcard).
+// Every name and glob must match at least one host group.
+func resolveHostGroups(ctx context.Context, z *zabbix.Client, patterns []string) ([]zabbix.HostGroup, error) {
+	names, globs := splitGlobs(patterns)
+	found := make(map[string]bool)
+	var groups []zabbix.HostGroup
+	add := func(result []zabbix.HostGroup) {
+		for _, g := range result {
+			if !found[g.GroupID] {
+				groups = append(groups, g)
+			}
+			found[g.GroupID] = true
+		}
+	}
+
+	var missing []string
+	if len(names) > 0 {
+		response, err := z.HostGroupGet(ctx, zabbix.NewGetAllHostGroupsRequest(
+			zabbix.WithHostGroupGetFilter(map[string]any{"name": names}),
+			zabbix.WithHostGroupGetAuth(z.Auth()),
+			zabbix.WithHostGroupGetID(1),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get host groups: %w", err)
+		}
+		add(response.Result)
+		for _, name := range names {
+			if !hostGroupMatchesName(response.Result, name) {
+				missing = append(missing, name)
+			}
+		}
+	}
+
+	for _, glob := range globs {
+		response, err := z.HostGroupGet(ctx, zabbix.NewGetAllHostGroupsRequest(
+			zabbix.WithHostGroupGetSearch(map[string]any{"name": glob}),
+			zabbix.WithHostGroupGetSearchWildcardsEnabled(true),
+			zabbix.WithHostGroupGetAuth(z.Auth()),
+			zabbix.WithHostGroupGetID(1),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get host groups: %w", err)
+		}
+		if len(response.Result) == 0 {
+			missing = append(missing, glob)
+		}
+		add(response.Result)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("host group(s) %s: %w", strings.Join(missing, ", "), errNotFound)
+	}
+	return groups, nil
+}
+
+// hostGroupMatchesName returns true if one of groups has the given name.
+func hostGroupMatchesName(groups []zabbix.HostGroup, name string) bool {
+	for _, g := range groups {
+		if g.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveHostGroupIDs returns the IDs of the host groups matching the given names or globs.
+func resolveHostGroupIDs(ctx context.Context, z *zabbix.Client, patterns []string) ([]string, error) {
+	groups, err := resolveHostGroups(ctx, z, patterns)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(groups))
+	for _, g := range groups {
+		ids = append(ids, g.GroupID)
+	}
+	return ids, nil
+}
+
+// resolveTemplates returns the templates matching the given names or globs ('*' wildcard).
+// Exact names are matched against the technical name, then the visible name.
+// Every name and glob must match at least one template.
+func resolveTemplates(ctx context.Context, z *zabbix.Client, patterns []string) ([]zabbix.Template, error) {
+	names, globs := splitGlobs(patterns)
+	output := []string{"templateid", "host", "name"}
+	found := make(map[string]bool)
+	var templates []zabbix.Template
+	add := func(result []zabbix.Template) {
+		for _, t := range result {
+			if !found[t.TemplateID] {
+				templates = append(templates, t)
+			}
+			found[t.TemplateID] = true
+		}
+	}
+
+	if len(names) > 0 {
+		for _, field := range []string{"host", "name"} {
+			response, err := z.TemplateGet(ctx, zabbix.NewTemplateGetRequest(
+				zabbix.WithTemplateGetOutput(output),
+				zabbix.WithTemplateGetFilter(map[string]any{field: names}),
+				zabbix.WithTemplateGetAuth(z.Auth()),
+			))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get templates: %w", err)
+			}
+			add(response.Result)
+		}
+	}
+
+	var missing []string
+	for _, name := range names {
+		if !templateMatchesName(templates, name) {
+			missing = append(missing, name)
+		}
+	}
+
+	for _, glob := range globs {
+		response, err := z.TemplateGet(ctx, zabbix.NewTemplateGetRequest(
+			zabbix.WithTemplateGetOutput(output),
+			zabbix.WithTemplateGetSearch(map[string]any{"host": glob, "name": glob}),
+			zabbix.WithTemplateGetSearchByAny(true),
+			zabbix.WithTemplateGetSearchWildcardsEnabled(true),
+			zabbix.WithTemplateGetAuth(z.Auth()),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get templates: %w", err)
+		}
+		if len(response.Result) == 0 {
+			missing = append(missing, glob)
+		}
+		add(response.Result)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("template(s) %s: %w", strings.Join(missing, ", "), errNotFound)
+	}
+	return templates, nil
+}
+
+// templateMatchesName returns true if one of templates has the given technical or visible name.
+func templateMatchesName(templates []zabbix.Template, name string) bool {
+	for _, t := range templates {
+		if t.Host == name || t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveTemplateIDs returns the IDs of the templates matching the given names or globs.
+func resolveTemplateIDs(ctx context.Context, z *zabbix.Client, patterns []string) ([]string, error) {
+	templates, err := resolveTemplates(ctx, z, patterns)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(templates))
+	for _, t := range templates {
+		ids = append(ids, t.TemplateID)
+	}
+	return ids, nil
+}
+
+// resolveTemplateGroupIDs returns the IDs of the template groups matching the given names or globs.
+func resolveTemplateGroupIDs(ctx context.Context, z *zabbix.Client, patterns []string) ([]string, error) {
+	names, globs := splitGlobs(patterns)
+	found := make(map[string]bool)
+	var ids []string
+	add := func(result []zabbix.TemplateGroup) {
+		for _, g := range result {
+			if !found[g.GroupID] {
+				ids = append(ids, g.GroupID)
+			}
+			found[g.GroupID] = true
+		}
+	}
+
+	var missing []string
+	if len(names) > 0 {
+		response, err := z.TemplateGroupGet(ctx, zabbix.NewTemplateGroupGetRequest(
+			zabbix.WithTemplateGroupGetFilter(map[string]any{"name": names}),
+			zabbix.WithTemplateGroupGetAuth(z.Auth()),
+			zabbix.WithTemplateGroupGetID(1),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get template groups: %w", err)
+		}
+		add(response.Result)
+		for _, name := range names {
+			matched := false
+			for _, g := range response.Result {
+				matched = matched || g.Name == name
+			}
+			if !matched {
+				missing = append(missing, name)
+			}
+		}
+	}
+
+	for _, glob := range globs {
+		response, err := z.TemplateGroupGet(ctx, zabbix.NewTemplateGroupGetRequest(
+			zabbix.WithTemplateGroupGetSearch(map[string]any{"name": glob}),
+			zabbix.WithTemplateGroupGetSearchWildcardsEnabled(true),
+			zabbix.WithTemplateGroupGetAuth(z.Auth()),
+			zabbix.WithTemplateGroupGetID(1),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get template groups: %w", err)
+		}
+		if len(response.Result) == 0 {
+			missing = append(missing, glob)
+		}
+		add(response.Result)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("template group(s) %s: %w", strings.Join(missing, ", "), errNotFound)
+	}
+	return ids, nil
+}
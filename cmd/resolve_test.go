@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newResolveTestServer returns a Zabbix API stub answering host.get, hostgroup.get, template.get
+// and templategroup.get from fixed data: exact names are matched with filter, globs with search.
+func newResolveTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+			Params struct {
+				Filter map[string][]string `json:"filter"`
+				Search map[string]string   `json:"search"`
+			} `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		result := "[]"
+		switch {
+		case req.Method == "host.get" && len(req.Params.Filter["host"]) > 0:
+			result = `[{"hostid":"1","host":"web01","name":"Web 01"}]`
+		case req.Method == "host.get" && len(req.Params.Filter["name"]) > 0:
+			result = `[{"hostid":"1","host":"web01","name":"Web 01"},{"hostid":"3","host":"lb01","name":"Load balancer"}]`
+		case req.Method == "host.get" && req.Params.Search["host"] == "db*":
+			result = `[{"hostid":"2","host":"db01","name":"db01"}]`
+		case req.Method == "hostgroup.get" && len(req.Params.Filter["name"]) > 0:
+			result = `[{"groupid":"10","name":"Linux servers"}]`
+		case req.Method == "template.get" && len(req.Params.Filter["host"]) > 0:
+			result = `[{"templateid":"10001","host":"Linux by Zabbix agent","name":"Linux by Zabbix agent"}]`
+		case req.Method == "template.get" && req.Params.Search["host"] == "Windows*":
+			result = `[{"templateid":"10081","host":"Windows by Zabbix agent","name":"Windows by Zabbix agent"}]`
+		case req.Method == "templategroup.get" && len(req.Params.Filter["name"]) > 0:
+			result = `[{"groupid":"13","name":"Templates/Databases"}]`
+		case req.Method == "templategroup.get" && req.Params.Search["name"] == "Templates/*":
+			result = `[{"groupid":"12","name":"Templates/Applications"},{"groupid":"13","name":"Templates/Databases"}]`
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","result":%s,"id":1}`, result)
+	}))
+}
+
+func TestResolveHostIDs(t *testing.T) {
+	t.Parallel()
+	ts := newResolveTestServer(t)
+	defer ts.Close()
+	client := zabbix.New("user", "pass", ts.URL)
+	z := &client
+
+	ids, err := resolveHostIDs(context.Background(), z, []string{"web01", "Load balancer", "db*"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "3", "2"}, ids)
+
+	_, err = resolveHostIDs(context.Background(), z, []string{"web01", "missing", "cache*"})
+	require.ErrorIs(t, err, errNotFound)
+	assert.Contains(t, err.Error(), "missing, cache*")
+}
+
+func TestResolveHostGroupIDs(t *testing.T) {
+	t.Parallel()
+	ts := newResolveTestServer(t)
+	defer ts.Close()
+	client := zabbix.New("user", "pass", ts.URL)
+	z := &client
+
+	ids, err := resolveHostGroupIDs(context.Background(), z, []string{"Linux servers"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10"}, ids)
+
+	_, err = resolveHostGroupIDs(context.Background(), z, []string{"Linux servers", "Windows servers"})
+	require.ErrorIs(t, err, errNotFound)
+}
+
+func TestResolveTemplateIDs(t *testing.T) {
+	t.Parallel()
+	ts := newResolveTestServer(t)
+	defer ts.Close()
+	client := zabbix.New("user", "pass", ts.URL)
+	z := &client
+
+	ids, err := resolveTemplateIDs(context.Background(), z, []string{"Linux by Zabbix agent", "Windows*"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10001", "10081"}, ids)
+
+	_, err = resolveTemplateIDs(context.Background(), z, []string{"Linux by Zabbix agent", "Template OS AIX"})
+	require.ErrorIs(t, err, errNotFound)
+	assert.Contains(t, err.Error(), "Template OS AIX")
+}
+
+func TestResolveTemplateGroupIDs(t *testing.T) {
+	t.Parallel()
+	ts := newResolveTestServer(t)
+	defer ts.Close()
+	client := zabbix.New("user", "pass", ts.URL)
+	z := &client
+
+	ids, err := resolveTemplateGroupIDs(context.Background(), z, []string{"Templates/Databases", "Templates/*"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"13", "12"}, ids)
+
+	_, err = resolveTemplateGroupIDs(context.Background(), z, []string{"Templates/Databases", "Templates/Network"})
+	require.ErrorIs(t, err, errNotFound)
+	assert.Contains(t, err.Error(), "Templates/Network")
+}
+
+func TestGlobMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern, s string
+		want       bool
+	}{
+		{"web01", "web01", true},
+		{"web01", "WEB01", true},
+		{"web01", "web012", false},
+		{"web*", "web01", true},
+		{"*01", "web01", true},
+		{"w*b*1", "web01", true},
+		{"deploy *", "Deploy api", true},
+		{"*", "", true},
+		{"a*b", "ab", true},
+		{"a*b*b", "ab", false},
+		{"db*", "web01", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, globMatch(tt.pattern, tt.s), "%s ~ %s", tt.pattern, tt.s)
+	}
+}
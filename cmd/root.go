@@ -30,6 +30,20 @@ var dashboardExportFormat string
 
 var ErrInvalidConfig = errors.New("invalid configuration")
 
+// Errors returned through silentError by commands that report their result with the exit status.
+var (
+	errLintFindings     = errors.New("maintenance lint reported findings")
+	errNotInMaintenance = errors.New("host not in maintenance")
+)
+
+// silentError returns err without letting cobra print it or the usage: Execute still exits
+// with status 1.
+func silentError(cmd *cobra.Command, err error) error {
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	return err
+}
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "zabbix-cli",
@@ -37,6 +51,8 @@ var rootCmd = &cobra.Command{
 	Long:  `A CLI tool to interact with Zabbix API`,
 }
 
+// Execute runs the root command and exits with status 1 on error, including errLintFindings
+// and errNotInMaintenance.
 func Execute() {
 	err := rootCmd.Execute()
 	if err != nil {
@@ -67,25 +83,56 @@ func init() {
 	rootCmd.AddCommand(exportCmd)
 	rootCmd.AddCommand(importCmd)
 
-	ProblemGetCmd.Flags().BoolVarP(&Ack, "ack", "a", false, "show acknowledged problems")
-	ProblemGetCmd.Flags().BoolVarP(&Supp, "supp", "s", false, "show suppressed problems")
-	ProblemGetCmd.Flags().StringVar(&problemSeverityFlag, "severity", "", "Filter problems by severity (e.g., 'Not classified', 'Information', 'Warning', 'Average', 'High', 'Disaster')")
-	ProblemGetCmd.Flags().StringVar(&problemDashboardName, "dashboard", "", "Apply filters from named dashboard (extracts filters from first 'problems' widget)")
 	ProblemCmd.AddCommand(ProblemGetCmd)
 	rootCmd.AddCommand(ProblemCmd)
 
 	rootCmd.AddCommand(ackCmd)
-	ackCmd.Flags().StringVar(&ackSeverityFlag, "severity", "", "Filter problems to acknowledge by severity (e.g., 'Not classified', 'Information', 'Warning', 'Average', 'High', 'Disaster')")
-	ackCmd.Flags().StringVar(&ackDashboardName, "dashboard", "", "Apply filters from named dashboard (extracts filters from first 'problems' widget)")
 
 	rootCmd.AddCommand(MaintenanceCmd)
 	MaintenanceCmd.AddCommand(MaintenanceCreateCmd)
 	MaintenanceCmd.AddCommand(MaintenanceDeleteCmd)
 	MaintenanceCmd.AddCommand(MaintenanceGetCmd)
+	MaintenanceCmd.AddCommand(MaintenanceUpdateCmd)
+	MaintenanceCmd.AddCommand(MaintenanceExtendCmd)
+	MaintenanceCmd.AddCommand(MaintenanceWrapCmd)
+	MaintenanceCmd.AddCommand(MaintenanceApplyCmd)
+	MaintenanceCmd.AddCommand(MaintenanceCalendarCmd)
+	MaintenanceCmd.AddCommand(MaintenanceActiveCmd)
+	MaintenanceCmd.AddCommand(MaintenanceLintCmd)
 	MaintenanceDeleteCmd.AddCommand(MaintenanceDeleteAllCmd)
 
 	rootCmd.AddCommand(HostgroupCmd)
 	HostgroupCmd.AddCommand(HostGroupGetCmd)
+	HostgroupCmd.AddCommand(HostGroupCreateCmd)
+	HostgroupCmd.AddCommand(HostGroupRenameCmd)
+	HostgroupCmd.AddCommand(HostGroupDeleteCmd)
+	HostgroupCmd.AddCommand(HostGroupAddHostsCmd)
+	HostgroupCmd.AddCommand(HostGroupRemoveHostsCmd)
+	HostgroupCmd.AddCommand(HostGroupMembersCmd)
+	HostgroupCmd.AddCommand(HostGroupTreeCmd)
+
+	rootCmd.AddCommand(HostCmd)
+	HostCmd.AddCommand(HostListCmd)
+	HostCmd.AddCommand(HostShowCmd)
+	HostCmd.AddCommand(HostEnableCmd)
+	HostCmd.AddCommand(HostDisableCmd)
+	HostCmd.AddCommand(HostCreateCmd)
+	HostCmd.AddCommand(HostUpdateCmd)
+	HostCmd.AddCommand(HostDeleteCmd)
+	HostCmd.AddCommand(HostImportCmd)
+	HostCmd.AddCommand(HostExportInventoryCmd)
+
+	rootCmd.AddCommand(MacroCmd)
+	MacroCmd.AddCommand(MacroListCmd)
+	MacroCmd.AddCommand(MacroSetCmd)
+	MacroCmd.AddCommand(MacroUnsetCmd)
+	MacroCmd.AddCommand(MacroDiffCmd)
+
+	rootCmd.AddCommand(TemplateCmd)
+	TemplateCmd.AddCommand(TemplateListCmd)
+	TemplateCmd.AddCommand(TemplateShowCmd)
+	TemplateCmd.AddCommand(TemplateLinkCmd)
+	TemplateCmd.AddCommand(TemplateUnlinkCmd)
 
 	rootCmd.AddCommand(DashboardCmd)
 	DashboardCmd.AddCommand(DashboardListCmd)
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+)
+
+// parseTag parses a "key=value" (or "key") tag given on the command line. The value is matched
+// with the contains operator, the default of Zabbix.
+func parseTag(value string) (zabbix.ProblemTag, error) {
+	key, val, _ := strings.Cut(value, "=")
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return zabbix.ProblemTag{}, fmt.Errorf("invalid tag %q (expected key=value)", value)
+	}
+	return zabbix.ProblemTag{Tag: key, Value: strings.TrimSpace(val), Operator: zabbix.MaintenanceTagOperatorContains}, nil
+}
+
+// parseTags parses a list of "key=value" tags.
+func parseTags(values []string) ([]zabbix.ProblemTag, error) {
+	tags := make([]zabbix.ProblemTag, 0, len(values))
+	for _, v := range values {
+		tag, err := parseTag(v)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// parseTagsEvalType parses a --tags-eval value ("and" or "or").
+func parseTagsEvalType(value string) (zabbix.TagsEvalType, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "and", "and/or":
+		return zabbix.TagsEvalTypeAnd, nil
+	case "or":
+		return zabbix.TagsEvalTypeOr, nil
+	default:
+		return zabbix.TagsEvalTypeAnd, fmt.Errorf("invalid tags evaluation %q (expected 'and' or 'or')", value)
+	}
+}
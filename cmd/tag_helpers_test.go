@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	tags, err := parseTags([]string{"service=nginx", "env = prod", "critical", "url=http://x?a=b"})
+	require.NoError(t, err)
+	contains := zabbix.MaintenanceTagOperatorContains
+	assert.Equal(t, []zabbix.ProblemTag{
+		{Tag: "service", Value: "nginx", Operator: contains},
+		{Tag: "env", Value: "prod", Operator: contains},
+		{Tag: "critical", Operator: contains},
+		{Tag: "url", Value: "http://x?a=b", Operator: contains},
+	}, tags)
+
+	_, err = parseTags([]string{"=value"})
+	require.Error(t, err)
+}
+
+func TestParseTagsEvalType(t *testing.T) {
+	t.Parallel()
+
+	evalType, err := parseTagsEvalType("OR")
+	require.NoError(t, err)
+	assert.Equal(t, zabbix.TagsEvalTypeOr, evalType)
+
+	evalType, err = parseTagsEvalType("and")
+	require.NoError(t, err)
+	assert.Equal(t, zabbix.TagsEvalTypeAnd, evalType)
+
+	_, err = parseTagsEvalType("xor")
+	require.Error(t, err)
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// TemplateCmd represents the template command (list, show, link, unlink)
+var TemplateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Manage templates",
+	Long:  `List and show templates, link and unlink templates on hosts and templates`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		// print help
+		cmd.Help() //nolint:errcheck
+		os.Exit(1)
+	},
+}
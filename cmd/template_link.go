@@ -0,0 +1,337 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	templateLinkHosts     []string
+	templateLinkHostGlobs []string
+	templateLinkGroups    []string
+	templateLinkTemplates []string
+	templateLinkClear     bool
+	templateLinkDryRun    bool
+	templateLinkYes       bool
+)
+
+// TemplateLinkCmd represents the template link command
+var TemplateLinkCmd = &cobra.Command{
+	Use:   "link <template...>",
+	Short: "Link templates to hosts or templates",
+	Long: `Link templates, given by name or glob ('*' wildcard), to hosts and templates.
+
+Hosts are given by name with --host, by glob with --host-glob or by host group with --group
+(all the hosts of the group); templates are given with --template. The hosts and templates
+already linked to all the templates are skipped. The affected hosts and templates are listed
+first, and a confirmation is asked when there are more than 5 of them, unless --yes is given.
+Each host and template is then updated separately and the result is reported for each one.
+
+Examples:
+  zabbix-cli template link 'Linux by Zabbix agent' --host web01 --host web02
+  zabbix-cli template link 'Nginx by Zabbix agent' --group 'Prod/EU/Web' --dry-run
+  zabbix-cli template link 'ICMP Ping' --template 'Linux by Zabbix agent'`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeTemplateLinks(cmd, args, true)
+	},
+}
+
+// TemplateUnlinkCmd represents the template unlink command
+var TemplateUnlinkCmd = &cobra.Command{
+	Use:   "unlink <template...>",
+	Short: "Unlink templates from hosts or templates",
+	Long: `Unlink templates, given by name or glob ('*' wildcard), from hosts and templates.
+
+Hosts and templates are given as for link; the ones the templates are not directly linked to
+are skipped. Without --clear, the items, triggers, graphs ... inherited from the templates are
+kept on the hosts as their own; with --clear, they are deleted with their history.
+
+Examples:
+  zabbix-cli template unlink 'Nginx by Zabbix agent' --host-glob 'web*'
+  zabbix-cli template unlink 'Linux by Zabbix agent' --group Decommissioned --clear --yes`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeTemplateLinks(cmd, args, false)
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{TemplateLinkCmd, TemplateUnlinkCmd} {
+		c.Flags().StringArrayVar(&templateLinkHosts, "host", nil, "Host name; repeatable")
+		c.Flags().StringArrayVar(&templateLinkHostGlobs, "host-glob", nil, "Host name pattern ('*' wildcard); repeatable")
+		c.Flags().StringArrayVar(&templateLinkGroups, "group", nil, "Host group whose hosts are changed, by name or glob; repeatable")
+		c.Flags().StringArrayVar(&templateLinkTemplates, "template", nil, "Template to change, by name or glob; repeatable")
+		c.Flags().BoolVar(&templateLinkDryRun, "dry-run", false, "List the hosts and templates that would be changed without changing them")
+		c.Flags().BoolVarP(&templateLinkYes, "yes", "y", false, "Do not ask for confirmation")
+	}
+	TemplateUnlinkCmd.Flags().BoolVar(&templateLinkClear, "clear", false, "Also delete the entities inherited from the templates")
+}
+
+// templateLinkTarget is a host or a template templates are linked to or unlinked from, with
+// the templates directly linked to it.
+type templateLinkTarget struct {
+	kind   string // host or template
+	id     string
+	name   string
+	linked []zabbix.Template
+}
+
+// templateLinkChange is a target with the templates to link to it or unlink from it.
+type templateLinkChange struct {
+	target    templateLinkTarget
+	templates []zabbix.Template
+}
+
+// changeTemplateLinks links (link) or unlinks the templates matching patterns on the hosts and
+// templates given by the flags.
+func changeTemplateLinks(cmd *cobra.Command, patterns []string, link bool) error {
+	ctx := context.Background()
+
+	z, logout, err := newClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer logout()
+
+	templates, err := resolveTemplates(ctx, z, patterns)
+	if err != nil {
+		return err
+	}
+	targets, err := getTemplateLinkTargets(ctx, z)
+	if err != nil {
+		return err
+	}
+
+	changes, skipped := planTemplateLinks(targets, templates, link)
+	out := cmd.OutOrStdout()
+	verb, done, preposition, skipReason := "Link", "linked", "to", "already linked"
+	if !link {
+		verb, done, preposition, skipReason = "Unlink", "unlinked", "from", "not linked"
+	}
+	if skipped > 0 {
+		fmt.Fprintf(out, "Skipping %d host(s) and template(s) %s\n", skipped, skipReason)
+	}
+	if len(changes) == 0 {
+		fmt.Fprintf(out, "No host or template to %s templates %s\n", strings.ToLower(verb), preposition)
+		return nil
+	}
+
+	printTemplateLinkPreview(out, changes)
+	if templateLinkDryRun {
+		fmt.Fprintf(out, "Dry run: templates would be %s %s %d host(s) and template(s)\n", done, preposition, len(changes))
+		return nil
+	}
+	if len(changes) > defaultConfirmThreshold && !templateLinkYes {
+		if !confirm(cmd.InOrStdin(), out, fmt.Sprintf("%s templates %s %d hosts and templates?", verb, preposition, len(changes))) {
+			return fmt.Errorf("aborted by user")
+		}
+	}
+
+	if !link && templateLinkClear {
+		done = "unlinked and cleared"
+	}
+	results := make([]string, 0, len(changes))
+	failed := 0
+	for _, c := range changes {
+		if err := applyTemplateLinkChange(ctx, z, &c, link, templateLinkClear); err != nil {
+			results = append(results, "failed: "+err.Error())
+			failed++
+		} else {
+			results = append(results, done)
+		}
+	}
+	printTemplateLinkResults(out, changes, results)
+
+	if failed > 0 {
+		return fmt.Errorf("failed to %s templates %s %d of %d host(s) and template(s)", strings.ToLower(verb), preposition, failed, len(changes))
+	}
+	fmt.Fprintf(out, "Successfully %s templates %s %d host(s) and template(s)\n", done, preposition, len(changes))
+	return nil
+}
+
+// getTemplateLinkTargets returns the hosts and templates given by --host, --host-glob, --group
+// and --template, with the templates directly linked to them.
+func getTemplateLinkTargets(ctx context.Context, z *zabbix.Client) ([]templateLinkTarget, error) {
+	hostPatterns := append(append([]string{}, templateLinkHosts...), templateLinkHostGlobs...)
+	if len(hostPatterns) == 0 && len(templateLinkGroups) == 0 && len(templateLinkTemplates) == 0 {
+		return nil, errors.New("no host or template given: use --host, --host-glob, --group or --template")
+	}
+
+	var hostIDs []string
+	if len(hostPatterns) > 0 {
+		ids, err := resolveHostIDs(ctx, z, hostPatterns)
+		if err != nil {
+			return nil, err
+		}
+		hostIDs = append(hostIDs, ids...)
+	}
+	if len(templateLinkGroups) > 0 {
+		groupIDs, err := resolveHostGroupIDs(ctx, z, templateLinkGroups)
+		if err != nil {
+			return nil, err
+		}
+		response, err := z.HostGet(ctx, zabbix.NewHostGetRequest(
+			zabbix.WithHostGetGroupIDs(groupIDs),
+			zabbix.WithHostGetOutput([]string{"hostid"}),
+			zabbix.WithHostGetAuth(z.Auth()),
+			zabbix.WithHostGetID(1),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the hosts of the host groups: %w", err)
+		}
+		for _, h := range response.Result {
+			hostIDs = append(hostIDs, h.HostID)
+		}
+	}
+
+	var targets []templateLinkTarget
+	if len(hostIDs) > 0 {
+		response, err := z.HostGet(ctx, zabbix.NewHostGetRequest(
+			zabbix.WithHostGetHostIDs(uniqueSorted(hostIDs)),
+			zabbix.WithHostGetOutput([]string{"hostid", "host", "name"}),
+			zabbix.WithHostGetSelectParentTemplates([]string{"templateid", "host", "name"}),
+			zabbix.WithHostGetSortField([]string{"host"}),
+			zabbix.WithHostGetAuth(z.Auth()),
+			zabbix.WithHostGetID(1),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get hosts: %w", err)
+		}
+		for _, h := range response.Result {
+			targets = append(targets, templateLinkTarget{kind: "host", id: h.HostID, name: h.Host, linked: h.ParentTemplates})
+		}
+	}
+	if len(templateLinkTemplates) > 0 {
+		templateIDs, err := resolveTemplateIDs(ctx, z, templateLinkTemplates)
+		if err != nil {
+			return nil, err
+		}
+		response, err := z.TemplateGet(ctx, zabbix.NewTemplateGetRequest(
+			zabbix.WithTemplateGetTemplateIDs(templateIDs),
+			zabbix.WithTemplateGetOutput([]string{"templateid", "host", "name"}),
+			zabbix.WithTemplateGetSelectParentTemplates([]string{"templateid", "host", "name"}),
+			zabbix.WithTemplateGetSortField([]string{"host"}),
+			zabbix.WithTemplateGetAuth(z.Auth()),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get templates: %w", err)
+		}
+		for _, t := range response.Result {
+			targets = append(targets, templateLinkTarget{kind: "template", id: t.TemplateID, name: t.Host, linked: t.ParentTemplates})
+		}
+	}
+	return targets, nil
+}
+
+// planTemplateLinks returns, for each target, the templates to link to it (link) or to unlink
+// from it, and the number of targets without anything to change. A template is never linked
+// to itself.
+func planTemplateLinks(targets []templateLinkTarget, templates []zabbix.Template, link bool) ([]templateLinkChange, int) {
+	var changes []templateLinkChange
+	skipped := 0
+	for _, target := range targets {
+		linked := make(map[string]bool, len(target.linked))
+		for _, t := range target.linked {
+			linked[t.TemplateID] = true
+		}
+		var selected []zabbix.Template
+		for _, t := range templates {
+			if target.kind == "template" && target.id == t.TemplateID {
+				continue
+			}
+			if linked[t.TemplateID] != link {
+				selected = append(selected, t)
+			}
+		}
+		if len(selected) == 0 {
+			skipped++
+			continue
+		}
+		sort.Slice(selected, func(i, j int) bool { return selected[i].DisplayName() < selected[j].DisplayName() })
+		changes = append(changes, templateLinkChange{target: target, templates: selected})
+	}
+	return changes, skipped
+}
+
+// applyTemplateLinkChange links or unlinks the templates of a change, clearing the inherited
+// entities when unlinking with clearEntities.
+func applyTemplateLinkChange(ctx context.Context, z *zabbix.Client, c *templateLinkChange, link, clearEntities bool) error {
+	ids := make([]string, 0, len(c.templates))
+	for _, t := range c.templates {
+		ids = append(ids, t.TemplateID)
+	}
+	var err error
+	switch {
+	case c.target.kind == "host" && link:
+		_, err = z.HostMassAdd(ctx, zabbix.NewHostMassAddRequest([]string{c.target.id},
+			zabbix.WithHostMassAddTemplates(ids),
+			zabbix.WithHostMassAddAuth(z.Auth()),
+			zabbix.WithHostMassAddID(1),
+		))
+	case c.target.kind == "host":
+		option := zabbix.WithHostMassRemoveTemplateIDs(ids)
+		if clearEntities {
+			option = zabbix.WithHostMassRemoveTemplateIDsClear(ids)
+		}
+		_, err = z.HostMassRemove(ctx, zabbix.NewHostMassRemoveRequest([]string{c.target.id},
+			option,
+			zabbix.WithHostMassRemoveAuth(z.Auth()),
+			zabbix.WithHostMassRemoveID(1),
+		))
+	case link:
+		_, err = z.TemplateMassAdd(ctx, zabbix.NewTemplateMassAddRequest([]string{c.target.id},
+			zabbix.WithTemplateMassAddTemplatesLink(ids),
+			zabbix.WithTemplateMassAddAuth(z.Auth()),
+			zabbix.WithTemplateMassAddID(1),
+		))
+	default:
+		option := zabbix.WithTemplateMassRemoveTemplateIDsLink(ids)
+		if clearEntities {
+			option = zabbix.WithTemplateMassRemoveTemplateIDsClear(ids)
+		}
+		_, err = z.TemplateMassRemove(ctx, zabbix.NewTemplateMassRemoveRequest([]string{c.target.id},
+			option,
+			zabbix.WithTemplateMassRemoveAuth(z.Auth()),
+			zabbix.WithTemplateMassRemoveID(1),
+		))
+	}
+	return err
+}
+
+// templateNames returns the comma-separated names of templates.
+func templateNames(templates []zabbix.Template) string {
+	names := make([]string, 0, len(templates))
+	for _, t := range templates {
+		names = append(names, t.DisplayName())
+	}
+	return strings.Join(names, ", ")
+}
+
+// printTemplateLinkPreview prints the hosts and templates to change with their templates.
+func printTemplateLinkPreview(out io.Writer, changes []templateLinkChange) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tNAME\tTEMPLATES")
+	for _, c := range changes {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", c.target.kind, c.target.name, templateNames(c.templates))
+	}
+	w.Flush()
+}
+
+// printTemplateLinkResults prints the result of each change.
+func printTemplateLinkResults(out io.Writer, changes []templateLinkChange, results []string) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tNAME\tRESULT")
+	for i, c := range changes {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", c.target.kind, c.target.name, results[i])
+	}
+	w.Flush()
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanTemplateLinks(t *testing.T) {
+	t.Parallel()
+
+	linux := zabbix.Template{TemplateID: "10001", Host: "Linux", Name: "Linux by Zabbix agent"}
+	icmp := zabbix.Template{TemplateID: "10002", Host: "ICMP Ping"}
+	targets := []templateLinkTarget{
+		{kind: "host", id: "10084", name: "web01", linked: []zabbix.Template{linux}},
+		{kind: "host", id: "10085", name: "web02", linked: []zabbix.Template{linux, icmp}},
+		{kind: "host", id: "10086", name: "web03"},
+		{kind: "template", id: "10001", name: "Linux"},
+	}
+
+	changes, skipped := planTemplateLinks(targets, []zabbix.Template{linux, icmp}, true)
+	assert.Equal(t, 1, skipped)
+	require.Len(t, changes, 3)
+	assert.Equal(t, "web01", changes[0].target.name)
+	assert.Equal(t, []zabbix.Template{icmp}, changes[0].templates)
+	assert.Equal(t, "web03", changes[1].target.name)
+	assert.Equal(t, []zabbix.Template{icmp, linux}, changes[1].templates)
+	assert.Equal(t, "Linux", changes[2].target.name)
+	assert.Equal(t, []zabbix.Template{icmp}, changes[2].templates)
+
+	changes, skipped = planTemplateLinks(targets, []zabbix.Template{linux}, false)
+	assert.Equal(t, 2, skipped)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "web01", changes[0].target.name)
+	assert.Equal(t, "web02", changes[1].target.name)
+}
+
+func TestPrintTemplateLinks(t *testing.T) {
+	t.Parallel()
+
+	changes := []templateLinkChange{
+		{
+			target:    templateLinkTarget{kind: "host", name: "web01"},
+			templates: []zabbix.Template{{Host: "ICMP Ping"}, {Host: "Linux", Name: "Linux by Zabbix agent"}},
+		},
+		{target: templateLinkTarget{kind: "template", name: "Nginx"}, templates: []zabbix.Template{{Host: "ICMP Ping"}}},
+	}
+
+	var out bytes.Buffer
+	printTemplateLinkPreview(&out, changes)
+	assert.Equal(t, `TYPE       NAME    TEMPLATES
+host       web01   ICMP Ping, Linux by Zabbix agent
+template   Nginx   ICMP Ping
+`, out.String())
+
+	out.Reset()
+	printTemplateLinkResults(&out, changes, []string{"linked", "failed: cannot link"})
+	assert.Equal(t, `TYPE       NAME    RESULT
+host       web01   linked
+template   Nginx   failed: cannot link
+`, out.String())
+}
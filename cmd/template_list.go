@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var (
+	templateListGroups []string
+	templateListName   string
+	templateListHosts  []string
+	templateListLimit  int
+	templateListOutput string
+)
+
+// TemplateListCmd represents the template list command
+var TemplateListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List templates",
+	Long: `List templates with their template groups and the number of hosts they are linked to.
+
+--group takes template group names or globs ('*' wildcard) and keeps the templates in one of
+the groups. --name matches the technical or visible name ('*' wildcard). --host takes host names
+or globs and keeps the templates directly linked to one of the hosts.
+
+Examples:
+  zabbix-cli template list --group 'Templates/Databases'
+  zabbix-cli template list --name '*MySQL*'
+  zabbix-cli template list --host web01 -o json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		options := []zabbix.TemplateGetOption{
+			zabbix.WithTemplateGetOutput([]string{"templateid", "host", "name"}),
+			zabbix.WithTemplateGetSelectTemplateGroups([]string{"groupid", "name"}),
+			zabbix.WithTemplateGetSelectHosts("count"),
+			zabbix.WithTemplateGetSortField([]string{"host"}),
+			zabbix.WithTemplateGetAuth(z.Auth()),
+			zabbix.WithTemplateGetID(1),
+		}
+		if len(templateListGroups) > 0 {
+			groupIDs, err := resolveTemplateGroupIDs(ctx, z, templateListGroups)
+			if err != nil {
+				return err
+			}
+			options = append(options, zabbix.WithTemplateGetGroupIDs(groupIDs))
+		}
+		if len(templateListHosts) > 0 {
+			hostIDs, err := resolveHostIDs(ctx, z, templateListHosts)
+			if err != nil {
+				return err
+			}
+			options = append(options, zabbix.WithTemplateGetHostIDs(hostIDs))
+		}
+		if templateListName != "" {
+			options = append(options,
+				zabbix.WithTemplateGetSearch(map[string]any{"host": templateListName, "name": templateListName}),
+				zabbix.WithTemplateGetSearchByAny(true),
+				zabbix.WithTemplateGetSearchWildcardsEnabled(true))
+		}
+		if templateListLimit > 0 {
+			options = append(options, zabbix.WithTemplateGetLimit(templateListLimit))
+		}
+
+		response, err := z.TemplateGet(ctx, zabbix.NewTemplateGetRequest(options...))
+		if err != nil {
+			return fmt.Errorf("failed to get templates: %w", err)
+		}
+
+		out := cmd.OutOrStdout()
+		if templateListOutput == "json" {
+			jsonOutput, err := json.MarshalIndent(response.Result, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal templates to JSON: %w", err)
+			}
+			fmt.Fprintln(out, string(jsonOutput))
+			return nil
+		}
+		printTemplateTable(out, response.Result)
+		return nil
+	},
+}
+
+func init() {
+	TemplateListCmd.Flags().StringArrayVar(&templateListGroups, "group", nil, "Template group, by name or glob; repeatable")
+	TemplateListCmd.Flags().StringVar(&templateListName, "name", "", "Technical or visible name ('*' wildcard)")
+	TemplateListCmd.Flags().StringArrayVar(&templateListHosts, "host", nil, "Host the templates are linked to, by name or glob; repeatable")
+	TemplateListCmd.Flags().IntVar(&templateListLimit, "limit", 0, "Maximum number of templates (0 for no limit)")
+	TemplateListCmd.Flags().StringVarP(&templateListOutput, "output", "o", "table", "Output format: table or json")
+}
+
+// printTemplateTable prints templates as a table.
+func printTemplateTable(out io.Writer, templates []zabbix.Template) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TEMPLATEID\tTEMPLATE\tNAME\tHOSTS\tGROUPS")
+	for _, t := range templates {
+		groups := make([]string, 0, len(t.TemplateGroups))
+		for _, g := range t.TemplateGroups {
+			groups = append(groups, g.Name)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", t.TemplateID, t.Host, t.Name, t.HostCount, strings.Join(groups, ","))
+	}
+	w.Flush()
+	fmt.Fprintf(out, "Total templates: %d\n", len(templates))
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/spf13/cobra"
+)
+
+var templateShowOutput string
+
+// TemplateShowCmd represents the template show command
+var TemplateShowCmd = &cobra.Command{
+	Use:   "show <name>",
+	Short: "Show the details of a template",
+	Long: `Show the details of a template given by technical or visible name: template groups, linked
+(parent) templates, tags, the number of items, triggers, graphs, discovery rules and web
+scenarios, the macros with their default values and the hosts the template is linked to.
+Secret macro values are never shown.
+
+Examples:
+  zabbix-cli template show 'Linux by Zabbix agent'
+  zabbix-cli template show 'Nginx by Zabbix agent' -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		z, logout, err := newClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer logout()
+
+		template, err := getTemplateDetails(ctx, z, args[0])
+		if err != nil {
+			return err
+		}
+		template.Macros = maskMacros(template.Macros)
+
+		out := cmd.OutOrStdout()
+		if templateShowOutput == "json" {
+			jsonOutput, err := json.MarshalIndent(template, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal template to JSON: %w", err)
+			}
+			fmt.Fprintln(out, string(jsonOutput))
+			return nil
+		}
+		printTemplateDetails(out, template)
+		return nil
+	},
+}
+
+func init() {
+	TemplateShowCmd.Flags().StringVarP(&templateShowOutput, "output", "o", "table", "Output format: table or json")
+}
+
+// getTemplateDetails returns the template with the given name, with its template groups,
+// parent templates, tags, macros, hosts and the numbers of its entities.
+func getTemplateDetails(ctx context.Context, z *zabbix.Client, name string) (*zabbix.Template, error) {
+	templates, err := resolveTemplates(ctx, z, []string{name})
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) > 1 {
+		return nil, fmt.Errorf("%q matches %d templates, give a single template", name, len(templates))
+	}
+	response, err := z.TemplateGet(ctx, zabbix.NewTemplateGetRequest(
+		zabbix.WithTemplateGetTemplateIDs([]string{templates[0].TemplateID}),
+		zabbix.WithTemplateGetOutput("extend"),
+		zabbix.WithTemplateGetSelectTemplateGroups([]string{"groupid", "name"}),
+		zabbix.WithTemplateGetSelectParentTemplates([]string{"templateid", "host", "name"}),
+		zabbix.WithTemplateGetSelectTags("extend"),
+		zabbix.WithTemplateGetSelectMacros("extend"),
+		zabbix.WithTemplateGetSelectHosts([]string{"hostid", "host", "name", "status"}),
+		zabbix.WithTemplateGetSelectItems("count"),
+		zabbix.WithTemplateGetSelectTriggers("count"),
+		zabbix.WithTemplateGetSelectGraphs("count"),
+		zabbix.WithTemplateGetSelectDiscoveries("count"),
+		zabbix.WithTemplateGetSelectHTTPTests("count"),
+		zabbix.WithTemplateGetAuth(z.Auth()),
+		zabbix.WithTemplateGetID(1),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template %q: %w", name, err)
+	}
+	if len(response.Result) == 0 {
+		return nil, fmt.Errorf("template %s: %w", name, errNotFound)
+	}
+	return &response.Result[0], nil
+}
+
+// printTemplateDetails prints a template with its groups, parent templates, tags, entity counts,
+// macros and hosts. Secret macro values are masked.
+func printTemplateDetails(out io.Writer, t *zabbix.Template) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Template:\t%s\n", t.Host)
+	fmt.Fprintf(w, "Name:\t%s\n", t.DisplayName())
+	fmt.Fprintf(w, "ID:\t%s\n", t.TemplateID)
+	if t.Description != "" {
+		fmt.Fprintf(w, "Description:\t%s\n", t.Description)
+	}
+	if t.Vendor.Name != "" {
+		fmt.Fprintf(w, "Vendor:\t%s\n", strings.TrimSpace(t.Vendor.Name+" "+t.Vendor.Version))
+	}
+	groups := make([]string, 0, len(t.TemplateGroups))
+	for _, g := range t.TemplateGroups {
+		groups = append(groups, g.Name)
+	}
+	fmt.Fprintf(w, "Groups:\t%s\n", strings.Join(groups, ", "))
+	fmt.Fprintf(w, "Templates:\t%s\n", templateNames(t.ParentTemplates))
+	tags := make([]string, 0, len(t.Tags))
+	for _, tag := range t.Tags {
+		tags = append(tags, formatProblemTag(zabbix.ProblemTag{Tag: tag.Tag, Value: tag.Value}))
+	}
+	fmt.Fprintf(w, "Tags:\t%s\n", strings.Join(tags, ", "))
+	fmt.Fprintf(w, "Items:\t%d\n", t.ItemCount)
+	fmt.Fprintf(w, "Triggers:\t%d\n", t.TriggerCount)
+	fmt.Fprintf(w, "Graphs:\t%d\n", t.GraphCount)
+	fmt.Fprintf(w, "Discoveries:\t%d\n", t.DiscoveryCount)
+	fmt.Fprintf(w, "Web scenarios:\t%d\n", t.HTTPTestCount)
+	w.Flush()
+
+	if len(t.Macros) > 0 {
+		fmt.Fprintln(out, "\nMacros:")
+		w = tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+		for _, m := range t.Macros {
+			value := m.Value
+			if m.IsSecret() {
+				value = secretMask
+			}
+			line := fmt.Sprintf("  %s\t%s", m.Macro, value)
+			if m.Description != "" {
+				line += "\t" + m.Description
+			}
+			fmt.Fprintln(w, line)
+		}
+		w.Flush()
+	}
+
+	if len(t.Hosts) > 0 {
+		fmt.Fprintf(out, "\nHosts (%d):\n", len(t.Hosts))
+		w = tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+		for i := range t.Hosts {
+			h := &t.Hosts[i]
+			status := "enabled"
+			if !h.IsMonitored() {
+				status = "disabled"
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", h.Host, h.DisplayName(), status)
+		}
+		w.Flush()
+	}
+}
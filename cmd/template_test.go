@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintTemplateTable(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	printTemplateTable(&out, []zabbix.Template{
+		{
+			TemplateID:     "10001",
+			Host:           "Linux by Zabbix agent",
+			Name:           "Linux by Zabbix agent",
+			HostCount:      12,
+			TemplateGroups: []zabbix.TemplateGroup{{Name: "Templates/Operating systems"}},
+		},
+		{TemplateID: "10002", Host: "MySQL", Name: "MySQL by Zabbix agent"},
+	})
+	assert.Equal(t, `TEMPLATEID   TEMPLATE                NAME                    HOSTS   GROUPS
+10001        Linux by Zabbix agent   Linux by Zabbix agent   12      Templates/Operating systems
+10002        MySQL                   MySQL by Zabbix agent   0       
+Total templates: 2
+`, out.String())
+}
+
+func TestPrintTemplateDetails(t *testing.T) {
+	t.Parallel()
+
+	template := &zabbix.Template{
+		TemplateID:      "10002",
+		Host:            "MySQL",
+		Name:            "MySQL by Zabbix agent",
+		TemplateGroups:  []zabbix.TemplateGroup{{Name: "Templates/Databases"}},
+		ParentTemplates: []zabbix.Template{{Host: "ICMP Ping"}},
+		Tags:            []zabbix.HostTag{{Tag: "class", Value: "database"}},
+		Macros: []zabbix.UserMacro{
+			{Macro: "{$MYSQL.PASSWORD}", Value: "s3cr3t", Type: zabbix.UserMacroTypeSecret},
+			{Macro: "{$MYSQL.PORT}", Value: "3306", Description: "MySQL port"},
+		},
+		Hosts: []zabbix.Host{
+			{Host: "db01", Name: "Database 01", Status: zabbix.HostStatusMonitored},
+			{Host: "db02", Status: zabbix.HostStatusUnmonitored},
+		},
+		ItemCount:      42,
+		TriggerCount:   7,
+		GraphCount:     3,
+		DiscoveryCount: 2,
+	}
+	template.Vendor.Name = "Zabbix"
+	template.Vendor.Version = "7.0-0"
+
+	var out bytes.Buffer
+	printTemplateDetails(&out, template)
+	assert.Equal(t, `Template:       MySQL
+Name:           MySQL by Zabbix agent
+ID:             10002
+Vendor:         Zabbix 7.0-0
+Groups:         Templates/Databases
+Templates:      ICMP Ping
+Tags:           class=database
+Items:          42
+Triggers:       7
+Graphs:         3
+Discoveries:    2
+Web scenarios:  0
+
+Macros:
+  {$MYSQL.PASSWORD}   ******
+  {$MYSQL.PORT}       3306   MySQL port
+
+Hosts (2):
+  db01   Database 01   enabled
+  db02   db02          disabled
+`, out.String())
+}
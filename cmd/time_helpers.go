@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeLayouts are the absolute time formats accepted on the command line.
+// Times without a zone are interpreted in the local time zone.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// dayWeekUnits matches the day and week units that time.ParseDuration does not support.
+var dayWeekUnits = regexp.MustCompile(`(\d+(?:\.\d+)?)([dw])`)
+
+// parseDuration parses a Go duration, extended with the "d" (24h) and "w" (7d) units,
+// e.g. "90m", "1h30m", "7d" or "2w3d".
+func parseDuration(value string) (time.Duration, error) {
+	var convErr error
+	expanded := dayWeekUnits.ReplaceAllStringFunc(strings.TrimSpace(value), func(match string) string {
+		parts := dayWeekUnits.FindStringSubmatch(match)
+		n, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			convErr = err
+			return match
+		}
+		hours := n * 24
+		if parts[2] == "w" {
+			hours *= 7
+		}
+		return strconv.FormatFloat(hours, 'f', -1, 64) + "h"
+	})
+	if convErr != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", value, convErr)
+	}
+	d, err := time.ParseDuration(expanded)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", value, err)
+	}
+	return d, nil
+}
+
+// parseTime parses an absolute time in one of timeLayouts, in the local time zone.
+func parseTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q (expected e.g. '2006-01-02 15:04' or RFC3339)", value)
+}
+
+// parseTimeOrDuration parses either an absolute time or a duration relative to now
+// (e.g. "2h" means two hours from now). "now" returns now.
+func parseTimeOrDuration(value string, now time.Time) (time.Time, error) {
+	if strings.EqualFold(strings.TrimSpace(value), "now") {
+		return now, nil
+	}
+	if d, err := parseDuration(value); err == nil {
+		return now.Add(d), nil
+	}
+	return parseTime(value)
+}
+
+// formatDuration formats a duration compactly with day, hour and minute units, e.g. "1h30m" or "2d".
+// Durations under a minute are given in seconds.
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+	minutes := int(d / time.Minute)
+	days, hours, minutes := minutes/(24*60), minutes/60%24, minutes%60
+	var b strings.Builder
+	if days > 0 {
+		fmt.Fprintf(&b, "%dd", days)
+	}
+	if hours > 0 {
+		fmt.Fprintf(&b, "%dh", hours)
+	}
+	if minutes > 0 {
+		fmt.Fprintf(&b, "%dm", minutes)
+	}
+	return b.String()
+}
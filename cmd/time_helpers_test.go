@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{"90m", 90 * time.Minute, false},
+		{"1h30m", 90 * time.Minute, false},
+		{"7d", 7 * 24 * time.Hour, false},
+		{"1w2d", 9 * 24 * time.Hour, false},
+		{"1.5d", 36 * time.Hour, false},
+		{"2d12h", 60 * time.Hour, false},
+		{"", 0, true},
+		{"tomorrow", 0, true},
+		{"12", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseDuration(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseDuration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.expected {
+			t.Errorf("parseDuration(%q) = %v, want %v", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestParseTimeOrDuration(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 10, 0, 0, 0, time.Local)
+	tests := []struct {
+		input    string
+		expected time.Time
+		wantErr  bool
+	}{
+		{"now", now, false},
+		{"2h", now.Add(2 * time.Hour), false},
+		{"1d", now.Add(24 * time.Hour), false},
+		{"2026-10-20 22:00", time.Date(2026, 10, 20, 22, 0, 0, 0, time.Local), false},
+		{"2026-10-20 22:00:30", time.Date(2026, 10, 20, 22, 0, 30, 0, time.Local), false},
+		{"2026-10-20", time.Date(2026, 10, 20, 0, 0, 0, 0, time.Local), false},
+		{"2026-10-20T22:00:00Z", time.Date(2026, 10, 20, 22, 0, 0, 0, time.UTC), false},
+		{"20/10/2026", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		got, err := parseTimeOrDuration(tt.input, now)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseTimeOrDuration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if !got.Equal(tt.expected) {
+			t.Errorf("parseTimeOrDuration(%q) = %v, want %v", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := map[time.Duration]string{
+		30 * time.Second:             "30s",
+		90 * time.Minute:             "1h30m",
+		2 * time.Hour:                "2h",
+		50*time.Hour + 5*time.Minute: "2d2h5m",
+		7 * 24 * time.Hour:           "7d",
+		time.Hour + 59*time.Second:   "1h",
+	}
+	for d, want := range tests {
+		if got := formatDuration(d); got != want {
+			t.Errorf("formatDuration(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
@@ -126,6 +126,101 @@ func (z *Client) HostGroupCreate(ctx context.Context, request *HostGroupCreateRe
 	return &response, nil
 }
 
+// HostGroupUpdate sends a hostgroup.update request to the Zabbix API.
+func (z *Client) HostGroupUpdate(ctx context.Context, request *HostGroupUpdateRequest) (*HostGroupUpdateResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for hostgroup.update: %w", err)
+	}
+
+	var response HostGroupUpdateResponse
+	if err := handleRawResponse(statusCode, respBody, "hostgroup.update", &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
+
+// HostGroupDelete sends a hostgroup.delete request to the Zabbix API.
+func (z *Client) HostGroupDelete(ctx context.Context, request *HostGroupDeleteRequest) (*HostGroupDeleteResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for hostgroup.delete: %w", err)
+	}
+
+	var response HostGroupDeleteResponse
+	if err := handleRawResponse(statusCode, respBody, "hostgroup.delete", &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
+
+// HostGroupMassAdd sends a hostgroup.massadd request to the Zabbix API.
+func (z *Client) HostGroupMassAdd(ctx context.Context, request *HostGroupMassAddRequest) (*HostGroupMassAddResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for hostgroup.massadd: %w", err)
+	}
+
+	var response HostGroupMassAddResponse
+	if err := handleRawResponse(statusCode, respBody, "hostgroup.massadd", &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
+
+// HostGroupMassRemove sends a hostgroup.massremove request to the Zabbix API.
+func (z *Client) HostGroupMassRemove(ctx context.Context, request *HostGroupMassRemoveRequest) (*HostGroupMassRemoveResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for hostgroup.massremove: %w", err)
+	}
+
+	var response HostGroupMassRemoveResponse
+	if err := handleRawResponse(statusCode, respBody, "hostgroup.massremove", &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
+
+// HostGroupPropagate sends a hostgroup.propagate request to the Zabbix API.
+func (z *Client) HostGroupPropagate(ctx context.Context, request *HostGroupPropagateRequest) (*HostGroupPropagateResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for hostgroup.propagate: %w", err)
+	}
+
+	var response HostGroupPropagateResponse
+	if err := handleRawResponse(statusCode, respBody, "hostgroup.propagate", &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
+
 // DashboardGet sends a dashboard.get request to the Zabbix API.
 // The request object should be fully populated by the caller, including Auth and ID.
 func (z *Client) DashboardGet(ctx context.Context, request *DashboardGetRequest) (*DashboardGetResponse, error) {
@@ -0,0 +1,128 @@
+package zabbix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MethodAPIInfoVersion is the Zabbix API method returning the API version.
+const MethodAPIInfoVersion = "apiinfo.version"
+
+// Version parsing constants
+const (
+	minVersionParts = 2 // A version has at least a major and a minor number
+	versionParts    = 3 // major.minor.patch
+)
+
+// API version errors
+var (
+	ErrInvalidAPIVersion = errors.New("invalid API version")
+	ErrUnsupportedAction = errors.New("action not supported by this Zabbix version")
+)
+
+// APIVersion is a parsed Zabbix API version, e.g. 7.0.3.
+type APIVersion struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseAPIVersion parses a version string as returned by apiinfo.version.
+// Pre-release suffixes such as "7.2.0beta1" are ignored.
+func ParseAPIVersion(version string) (APIVersion, error) {
+	parts := strings.Split(strings.TrimSpace(version), ".")
+	if len(parts) < minVersionParts {
+		return APIVersion{}, fmt.Errorf("%w: %q", ErrInvalidAPIVersion, version)
+	}
+
+	numbers := make([]int, versionParts)
+	for i := 0; i < len(parts) && i < len(numbers); i++ {
+		digits := parts[i]
+		if end := strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }); end >= 0 {
+			digits = digits[:end]
+		}
+		n, err := strconv.Atoi(digits)
+		if err != nil {
+			return APIVersion{}, fmt.Errorf("%w: %q", ErrInvalidAPIVersion, version)
+		}
+		numbers[i] = n
+	}
+	return APIVersion{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+// AtLeast returns true if the version is greater than or equal to major.minor.
+func (v APIVersion) AtLeast(major, minor int) bool {
+	if v.Major != major {
+		return v.Major > major
+	}
+	return v.Minor >= minor
+}
+
+// String returns the version as "major.minor.patch".
+func (v APIVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// apiInfoVersionRequest is the request for apiinfo.version.
+// This method must be called without authentication.
+type apiInfoVersionRequest struct {
+	JSONRPC string   `json:"jsonrpc"`
+	Method  string   `json:"method"`
+	Params  []string `json:"params"`
+	ID      int      `json:"id"`
+}
+
+// apiInfoVersionResponse is the response of apiinfo.version.
+type apiInfoVersionResponse struct {
+	JSONRPC string `json:"jsonrpc"`
+	Result  string `json:"result"`
+	Error   *Error `json:"error,omitempty"`
+	ID      int    `json:"id"`
+}
+
+// APIVersion returns the version of the Zabbix API.
+func (z *Client) APIVersion(ctx context.Context) (APIVersion, error) {
+	request := apiInfoVersionRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodAPIInfoVersion,
+		Params:  []string{},
+		ID:      generateUniqueID(),
+	}
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return APIVersion{}, fmt.Errorf("API request failed for apiinfo.version: %w", err)
+	}
+
+	var response apiInfoVersionResponse
+	if err := handleRawResponse(statusCode, respBody, MethodAPIInfoVersion, &response); err != nil {
+		return APIVersion{}, err
+	}
+	if response.Error != nil && response.Error.Code != 0 {
+		return APIVersion{}, response.Error
+	}
+	return ParseAPIVersion(response.Result)
+}
+
+// eventActionMinVersions lists the Zabbix version that introduced each event.acknowledge action.
+// Actions that are not listed are supported by every version handled by this package.
+var eventActionMinVersions = map[EventAction]APIVersion{
+	ChangeSeverity: {Major: 4, Minor: 0},
+	Unacknowledge:  {Major: 6, Minor: 0},
+	Suppress:       {Major: 6, Minor: 2},
+	Unsuppress:     {Major: 6, Minor: 2},
+}
+
+// ValidateEventActions checks that every action of the bitmask is supported by the given API version.
+func ValidateEventActions(version APIVersion, action EventAction) error {
+	for _, a := range RetrieveActions(action) {
+		minVersion, ok := eventActionMinVersions[a]
+		if ok && !version.AtLeast(minVersion.Major, minVersion.Minor) {
+			return fmt.Errorf("%w: %s requires Zabbix %d.%d or later (server is %s)",
+				ErrUnsupportedAction, a, minVersion.Major, minVersion.Minor, version)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package zabbix_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected zabbix.APIVersion
+		wantErr  bool
+	}{
+		{"7.0.3", zabbix.APIVersion{Major: 7, Minor: 0, Patch: 3}, false},
+		{"6.4", zabbix.APIVersion{Major: 6, Minor: 4}, false},
+		{"7.2.0beta1", zabbix.APIVersion{Major: 7, Minor: 2}, false},
+		{" 5.0.42 ", zabbix.APIVersion{Major: 5, Minor: 0, Patch: 42}, false},
+		{"7", zabbix.APIVersion{}, true},
+		{"", zabbix.APIVersion{}, true},
+		{"x.y", zabbix.APIVersion{}, true},
+	}
+	for _, tt := range tests {
+		got, err := zabbix.ParseAPIVersion(tt.input)
+		if tt.wantErr {
+			require.ErrorIs(t, err, zabbix.ErrInvalidAPIVersion, tt.input)
+			continue
+		}
+		require.NoError(t, err, tt.input)
+		require.Equal(t, tt.expected, got)
+	}
+}
+
+func TestAPIVersionAtLeast(t *testing.T) {
+	t.Parallel()
+
+	v := zabbix.APIVersion{Major: 6, Minor: 2, Patch: 1}
+	require.True(t, v.AtLeast(6, 2))
+	require.True(t, v.AtLeast(6, 0))
+	require.True(t, v.AtLeast(5, 4))
+	require.False(t, v.AtLeast(6, 4))
+	require.False(t, v.AtLeast(7, 0))
+	require.Equal(t, "6.2.1", v.String())
+}
+
+func TestValidateEventActions(t *testing.T) {
+	t.Parallel()
+
+	v50 := zabbix.APIVersion{Major: 5, Minor: 0}
+	v60 := zabbix.APIVersion{Major: 6, Minor: 0}
+	v70 := zabbix.APIVersion{Major: 7, Minor: 0}
+
+	require.NoError(t, zabbix.ValidateEventActions(v50, zabbix.Acknowledge|zabbix.ChangeSeverity|zabbix.AddMessage))
+	require.ErrorIs(t, zabbix.ValidateEventActions(v50, zabbix.Unacknowledge), zabbix.ErrUnsupportedAction)
+	require.NoError(t, zabbix.ValidateEventActions(v60, zabbix.Unacknowledge))
+	require.ErrorIs(t, zabbix.ValidateEventActions(v60, zabbix.Acknowledge|zabbix.Suppress), zabbix.ErrUnsupportedAction)
+	require.ErrorIs(t, zabbix.ValidateEventActions(v60, zabbix.Unsuppress), zabbix.ErrUnsupportedAction)
+	require.NoError(t, zabbix.ValidateEventActions(v70, zabbix.Suppress|zabbix.Unsuppress))
+}
+
+func TestClientAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "apiinfo.version", req["method"])
+		// apiinfo.version must be called without authentication
+		require.NotContains(t, req, "auth")
+		fmt.Fprintln(w, `{"jsonrpc":"2.0","result":"7.0.5","id":1}`)
+	}))
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	v, err := z.APIVersion(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, zabbix.APIVersion{Major: 7, Minor: 0, Patch: 5}, v)
+}
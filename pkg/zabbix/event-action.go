@@ -66,3 +66,26 @@ func NewEventAction(actions ...EventAction) int {
 	}
 	return action
 }
+
+// String returns a short, human readable name for a single event action.
+// Combined bitmasks should be split with RetrieveActions first.
+func (a EventAction) String() string {
+	switch a {
+	case CloseProblem:
+		return "close"
+	case Acknowledge:
+		return "acknowledge"
+	case AddMessage:
+		return "message"
+	case ChangeSeverity:
+		return "severity"
+	case Unacknowledge:
+		return "unacknowledge"
+	case Suppress:
+		return "suppress"
+	case Unsuppress:
+		return "unsuppress"
+	default:
+		return "unknown"
+	}
+}
@@ -203,3 +203,19 @@ func TestNewEventActionAndRetrieveRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestEventActionString(t *testing.T) {
+	tests := map[zabbix.EventAction]string{
+		zabbix.CloseProblem:   "close",
+		zabbix.Acknowledge:    "acknowledge",
+		zabbix.AddMessage:     "message",
+		zabbix.ChangeSeverity: "severity",
+		zabbix.Unacknowledge:  "unacknowledge",
+		zabbix.Suppress:       "suppress",
+		zabbix.Unsuppress:     "unsuppress",
+		zabbix.EventAction(3): "unknown",
+	}
+	for action, expected := range tests {
+		require.Equal(t, expected, action.String())
+	}
+}
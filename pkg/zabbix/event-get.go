@@ -0,0 +1,247 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// MethodEventGet is the Zabbix API method for getting events.
+const MethodEventGet = "event.get"
+
+// Event values for trigger events.
+const (
+	// EventValueOK is the value of a recovery (OK) event.
+	EventValueOK = "0"
+	// EventValueProblem is the value of a problem event.
+	EventValueProblem = "1"
+)
+
+// Event represents a Zabbix event object as returned by event.get.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/event/object
+type Event struct {
+	EventID       string      `json:"eventid"`
+	Source        string      `json:"source"`                  // Type of the event: 0 - trigger, 1 - discovery, 2 - autoregistration, 3 - internal, 4 - service.
+	Object        string      `json:"object"`                  // Type of object related to the event: 0 - trigger for trigger events.
+	ObjectID      string      `json:"objectid"`                // ID of the related object.
+	Clock         StringInt64 `json:"clock"`                   // Unix timestamp when the event was created.
+	Ns            StringInt64 `json:"ns"`                      // Nanoseconds when the event was created.
+	Name          string      `json:"name"`                    // Resolved event name.
+	Value         string      `json:"value"`                   // State of the related object: 0 - OK, 1 - problem for trigger events.
+	Acknowledged  BoolString  `json:"acknowledged"`            // Whether the event has been acknowledged.
+	Severity      string      `json:"severity"`                // Event current severity ("0"-"5").
+	ReventID      string      `json:"r_eventid,omitempty"`     // Recovery event ID.
+	CeventID      string      `json:"c_eventid,omitempty"`     // ID of the event that was used to override (close) current event under global correlation rule.
+	CauseEventID  string      `json:"cause_eventid,omitempty"` // Cause event ID.
+	CorrelationID string      `json:"correlationid,omitempty"` // Correlation rule ID if this event was recovered by global correlation rule.
+	UserID        string      `json:"userid,omitempty"`        // User ID if the event was manually closed.
+	Suppressed    BoolString  `json:"suppressed"`              // Whether the event is suppressed.
+	Opdata        string      `json:"opdata,omitempty"`        // Operational data with expanded macros.
+
+	// Fields populated by select queries
+	Acknowledges []AcknowledgeEntry   `json:"acknowledges,omitempty"` // Populated by select_acknowledges
+	Tags         []ProblemResponseTag `json:"tags,omitempty"`         // Populated by selectTags
+	Hosts        []HostInfo           `json:"hosts,omitempty"`        // Populated by selectHosts
+}
+
+// GetClock returns the clock as a time.Time.
+func (e *Event) GetClock() time.Time {
+	return time.Unix(e.Clock.Int64(), 0)
+}
+
+// IsProblem returns true if the event is a problem event.
+func (e *Event) IsProblem() bool {
+	return e.Value == EventValueProblem
+}
+
+// EventGetParams defines the parameters for the Zabbix event.get API call.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/event/get
+type EventGetParams struct {
+	CommonGetParams // Embeds common parameters like Output, Limit, Filter, etc.
+
+	EventIDs     []string            `json:"eventids,omitempty"`
+	GroupIDs     []string            `json:"groupids,omitempty"`
+	HostIDs      []string            `json:"hostids,omitempty"`
+	ObjectIDs    []string            `json:"objectids,omitempty"`
+	Source       int                 `json:"source,omitempty"` // Default: 0 - trigger events.
+	Object       int                 `json:"object,omitempty"` // Default: 0 - trigger.
+	Acknowledged bool                `json:"acknowledged,omitempty"`
+	Suppressed   bool                `json:"suppressed,omitempty"`
+	Severities   []string            `json:"severities,omitempty"`
+	EvalType     int                 `json:"evaltype,omitempty"`
+	Tags         []FilterProblemTags `json:"tags,omitempty"`
+	EventIDFrom  string              `json:"eventid_from,omitempty"` // Return only events with IDs greater or equal to the given ID.
+	EventIDTill  string              `json:"eventid_till,omitempty"` // Return only events with IDs less or equal to the given ID.
+	TimeFrom     int64               `json:"time_from,omitempty"`
+	TimeTill     int64               `json:"time_till,omitempty"`
+	Value        []string            `json:"value,omitempty"` // Return only events with the given values.
+
+	SelectHosts        any `json:"selectHosts,omitempty"`         // "extend" or array of fields
+	SelectTags         any `json:"selectTags,omitempty"`          // "extend" or array of fields
+	SelectAcknowledges any `json:"select_acknowledges,omitempty"` // "extend" or array of fields
+}
+
+// EventGetRequest defines the JSON-RPC request structure for event.get.
+type EventGetRequest struct {
+	JSONRPC string         `json:"jsonrpc"`
+	Method  string         `json:"method"`
+	Params  EventGetParams `json:"params"`
+	Auth    string         `json:"auth,omitempty"`
+	ID      int            `json:"id"`
+}
+
+// EventGetResponse defines the JSON-RPC response structure for event.get.
+type EventGetResponse struct {
+	JSONRPC string  `json:"jsonrpc"`
+	Result  []Event `json:"result"`
+	ID      int     `json:"id"`
+	Error   *Error  `json:"error,omitempty"`
+}
+
+// EventGetOption defines a function signature for options to configure an EventGetRequest.
+type EventGetOption func(*EventGetRequest)
+
+// NewEventGetRequest creates a new EventGetRequest with default values and applies any provided options.
+func NewEventGetRequest(options ...EventGetOption) *EventGetRequest {
+	egr := &EventGetRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodEventGet,
+		Params:  EventGetParams{},
+		ID:      generateUniqueID(),
+	}
+	for _, opt := range options {
+		opt(egr)
+	}
+	return egr
+}
+
+// --- Option functions for EventGetParams ---
+
+// WithEventGetEventIDs sets the event IDs for the request.
+func WithEventGetEventIDs(ids []string) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.EventIDs = ids }
+}
+
+// WithEventGetGroupIDs sets the host group IDs for the request.
+func WithEventGetGroupIDs(ids []string) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.GroupIDs = ids }
+}
+
+// WithEventGetHostIDs sets the host IDs for the request.
+func WithEventGetHostIDs(ids []string) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.HostIDs = ids }
+}
+
+// WithEventGetObjectIDs sets the object IDs for the request.
+func WithEventGetObjectIDs(ids []string) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.ObjectIDs = ids }
+}
+
+// WithEventGetSeverities sets the severities for the request.
+func WithEventGetSeverities(severities []string) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.Severities = severities }
+}
+
+// WithEventGetTags sets the tags filter for the request.
+func WithEventGetTags(tags []FilterProblemTags) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.Tags = tags }
+}
+
+// WithEventGetEventIDFrom sets the eventid_from parameter.
+func WithEventGetEventIDFrom(eventID string) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.EventIDFrom = eventID }
+}
+
+// WithEventGetEventIDTill sets the eventid_till parameter.
+func WithEventGetEventIDTill(eventID string) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.EventIDTill = eventID }
+}
+
+// WithEventGetTimeFrom sets the time_from parameter.
+func WithEventGetTimeFrom(timeFrom int64) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.TimeFrom = timeFrom }
+}
+
+// WithEventGetTimeTill sets the time_till parameter.
+func WithEventGetTimeTill(timeTill int64) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.TimeTill = timeTill }
+}
+
+// WithEventGetValue sets the event values to return (see EventValueOK and EventValueProblem).
+func WithEventGetValue(values []string) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.Value = values }
+}
+
+// WithEventGetSelectHosts sets the selectHosts parameter.
+func WithEventGetSelectHosts(query any) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.SelectHosts = query }
+}
+
+// WithEventGetSelectTags sets the selectTags parameter.
+func WithEventGetSelectTags(query any) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.SelectTags = query }
+}
+
+// WithEventGetSelectAcknowledges sets the select_acknowledges parameter.
+func WithEventGetSelectAcknowledges(query any) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.SelectAcknowledges = query }
+}
+
+// --- Option functions for CommonGetParams (embedded) ---
+
+// WithEventGetOutput sets the output parameter.
+func WithEventGetOutput(output any) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.Output = output }
+}
+
+// WithEventGetLimit sets the limit parameter.
+func WithEventGetLimit(limit int) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.Limit = limit }
+}
+
+// WithEventGetFilter sets the filter parameter.
+func WithEventGetFilter(filter map[string]any) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.Filter = filter }
+}
+
+// WithEventGetSortField sets the sortfield parameter.
+func WithEventGetSortField(sortField []string) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.SortField = sortField }
+}
+
+// WithEventGetSortOrder sets the sortorder parameter.
+func WithEventGetSortOrder(sortOrder []string) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Params.SortOrder = sortOrder }
+}
+
+// --- Option functions for request Auth and ID ---
+
+// WithEventGetAuth sets the authentication token for the API request.
+func WithEventGetAuth(token string) EventGetOption {
+	return func(egr *EventGetRequest) { egr.Auth = token }
+}
+
+// WithEventGetID sets the ID for the API request.
+func WithEventGetID(id int) EventGetOption {
+	return func(egr *EventGetRequest) { egr.ID = id }
+}
+
+// EventGet sends an event.get request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) EventGet(ctx context.Context, request *EventGetRequest) (*EventGetResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for event.get: %w", err)
+	}
+
+	var response EventGetResponse
+	if err := handleRawResponse(statusCode, respBody, MethodEventGet, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,111 @@
+package zabbix_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventGetRequest(t *testing.T) {
+	t.Parallel()
+
+	req := zabbix.NewEventGetRequest(
+		zabbix.WithEventGetAuth("token"),
+		zabbix.WithEventGetID(7),
+		zabbix.WithEventGetOutput("extend"),
+		zabbix.WithEventGetEventIDFrom("101"),
+		zabbix.WithEventGetEventIDTill("200"),
+		zabbix.WithEventGetValue([]string{zabbix.EventValueProblem}),
+		zabbix.WithEventGetSelectHosts([]string{"hostid", "name"}),
+		zabbix.WithEventGetSelectTags("extend"),
+		zabbix.WithEventGetSelectAcknowledges("extend"),
+		zabbix.WithEventGetSortField([]string{"eventid"}),
+		zabbix.WithEventGetSortOrder([]string{"ASC"}),
+		zabbix.WithEventGetLimit(50),
+	)
+
+	require.Equal(t, zabbix.JSONRPC, req.JSONRPC)
+	require.Equal(t, zabbix.MethodEventGet, req.Method)
+	require.Equal(t, "token", req.Auth)
+	require.Equal(t, 7, req.ID)
+
+	data, err := json.Marshal(req.Params)
+	require.NoError(t, err)
+	var params map[string]any
+	require.NoError(t, json.Unmarshal(data, &params))
+	require.Equal(t, "101", params["eventid_from"])
+	require.Equal(t, "200", params["eventid_till"])
+	require.Equal(t, []any{"1"}, params["value"])
+	require.Equal(t, "extend", params["select_acknowledges"])
+	require.Equal(t, "extend", params["selectTags"])
+	require.Equal(t, []any{"hostid", "name"}, params["selectHosts"])
+	require.InDelta(t, 50, params["limit"], 0)
+	require.NotContains(t, params, "eventids")
+}
+
+func TestEventGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req zabbix.EventGetRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, "event.get", req.Method)
+			require.Equal(t, "11", req.Params.EventIDFrom)
+
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintln(w, `{"jsonrpc":"2.0","result":[
+				{"eventid":"11","objectid":"500","clock":"1700000000","name":"CPU high","value":"1","severity":"4","acknowledged":"0","suppressed":"0",
+				 "hosts":[{"hostid":"10084","name":"web01"}],"tags":[{"tag":"service","value":"web"}]},
+				{"eventid":"12","objectid":"500","clock":"1700000060","name":"CPU high","value":"0","severity":"0","acknowledged":"0","suppressed":"0"}
+			],"id":1}`)
+		}))
+		defer ts.Close()
+
+		z := zabbix.New("user", "pass", ts.URL)
+		resp, err := z.EventGet(context.Background(), zabbix.NewEventGetRequest(zabbix.WithEventGetEventIDFrom("11")))
+		require.NoError(t, err)
+		require.Len(t, resp.Result, 2)
+
+		problem := resp.Result[0]
+		require.True(t, problem.IsProblem())
+		require.Equal(t, int64(1700000000), problem.GetClock().Unix())
+		require.Equal(t, "web01", problem.Hosts[0].Name)
+		require.Equal(t, "service", problem.Tags[0].Tag)
+		require.False(t, resp.Result[1].IsProblem())
+	})
+
+	t.Run("zabbix error", func(t *testing.T) {
+		t.Parallel()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, `{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params.","data":"Not authorised."},"id":1}`)
+		}))
+		defer ts.Close()
+
+		z := zabbix.New("user", "pass", ts.URL)
+		_, err := z.EventGet(context.Background(), zabbix.NewEventGetRequest())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Not authorised.")
+	})
+
+	t.Run("non-200 status", func(t *testing.T) {
+		t.Parallel()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintln(w, "boom")
+		}))
+		defer ts.Close()
+
+		z := zabbix.New("user", "pass", ts.URL)
+		_, err := z.EventGet(context.Background(), zabbix.NewEventGetRequest())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "event.get")
+	})
+}
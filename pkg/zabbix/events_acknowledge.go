@@ -20,7 +20,9 @@ type EventsAcknowledgeParams struct {
 	// 2 - acknowledge event;
 	// 4 - add message;
 	// 8 - change severity;
-	// 16 - unacknowledge event.
+	// 16 - unacknowledge event;
+	// 32 - suppress event;
+	// 64 - unsuppress event.
 
 	// This is a bitmask field; any sum of possible bitmap values is acceptable (for example, 6 for acknowledge event and add message).
 	Action  int    `json:"action"`
@@ -28,6 +30,27 @@ type EventsAcknowledgeParams struct {
 
 	// 	New severity for events.
 	Severity Severity `json:"severity,omitempty"` // Required only if action contains 'change severity' flag (8).
+
+	// Unix timestamp until which the events are suppressed. 0 suppresses them indefinitely.
+	SuppressUntil int64 `json:"suppress_until,omitempty"` // Used only if action contains 'suppress event' flag (32).
+}
+
+// MarshalJSON always includes the severity when the 'change severity' action is requested,
+// as "Not classified" (0) would otherwise be dropped by omitempty.
+func (p EventsAcknowledgeParams) MarshalJSON() ([]byte, error) {
+	type alias EventsAcknowledgeParams
+	var payload any = alias(p)
+	if p.Action&int(ChangeSeverity) != 0 {
+		payload = struct {
+			alias
+			Severity Severity `json:"severity"`
+		}{alias(p), p.Severity}
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal event acknowledge params: %w", err)
+	}
+	return data, nil
 }
 
 // EventAcknowledgeRequest represents a request to acknowledge Zabbix events.
@@ -67,6 +90,14 @@ func WithMessage(message string) EventAcknowledgeRequestOption {
 	}
 }
 
+// WithSuppressUntil sets the time until which the events are suppressed (Unix timestamp, 0 for indefinitely).
+// WithSuppressUntil returns a EventAcknowledgeRequestOption that sets the suppress_until parameter.
+func WithSuppressUntil(suppressUntil int64) EventAcknowledgeRequestOption {
+	return func(e *EventAcknowledgeRequest) {
+		e.Params.SuppressUntil = suppressUntil
+	}
+}
+
 // newEventAcknowledgeRequest creates a new event acknowledge request.
 func newEventAcknowledgeRequest(eventids []string, opts ...EventAcknowledgeRequestOption) *EventAcknowledgeRequest {
 	req := &EventAcknowledgeRequest{
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -350,3 +351,52 @@ func TestAcknowledgeEventsWithOptions(t *testing.T) {
 		require.Equal(t, 64, receivedParams.Action)
 	})
 }
+
+func TestEventsAcknowledgeParamsMarshal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not classified severity is kept when changing severity", func(t *testing.T) {
+		params := zabbix.EventsAcknowledgeParams{
+			Eventids: []string{"1"},
+			Action:   zabbix.NewEventAction(zabbix.ChangeSeverity),
+			Severity: zabbix.NotClassified,
+		}
+		data, err := json.Marshal(params)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"severity":0`)
+		require.Equal(t, 1, strings.Count(string(data), `"severity"`))
+	})
+
+	t.Run("severity is omitted without change severity action", func(t *testing.T) {
+		params := zabbix.EventsAcknowledgeParams{
+			Eventids: []string{"1"},
+			Action:   zabbix.NewEventAction(zabbix.Acknowledge),
+		}
+		data, err := json.Marshal(params)
+		require.NoError(t, err)
+		require.NotContains(t, string(data), `"severity"`)
+		require.NotContains(t, string(data), `"suppress_until"`)
+	})
+
+	t.Run("suppress until", func(t *testing.T) {
+		var received zabbix.EventsAcknowledgeParams
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				Params zabbix.EventsAcknowledgeParams `json:"params"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			received = req.Params
+			fmt.Fprintln(w, `{"jsonrpc":"2.0","result":{"eventids":[1]},"id":1}`)
+		}))
+		defer ts.Close()
+
+		z := zabbix.New("user", "pass", ts.URL)
+		_, err := z.AcknowledgeEvents(context.Background(), []string{"1"},
+			zabbix.WithActions(zabbix.Suppress),
+			zabbix.WithSuppressUntil(1700000000),
+		)
+		require.NoError(t, err)
+		require.Equal(t, int64(1700000000), received.SuppressUntil)
+		require.Equal(t, 32, received.Action)
+	})
+}
@@ -0,0 +1,95 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+)
+
+// MethodHostCreate is the Zabbix API method for creating hosts.
+const MethodHostCreate = "host.create"
+
+// TemplateID references a template by ID in host requests.
+type TemplateID struct {
+	TemplateID string `json:"templateid"`
+}
+
+// HostParams holds the properties of a host sent to host.create and host.update.
+// Only the properties that are set are sent: on update, they replace the current ones.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/host/create
+type HostParams struct {
+	HostID        string          `json:"hostid,omitempty"`         // Required by host.update.
+	Host          string          `json:"host,omitempty"`           // Technical name, required by host.create.
+	Name          string          `json:"name,omitempty"`           // Visible name.
+	Description   string          `json:"description,omitempty"`    // Description of the host.
+	Status        string          `json:"status,omitempty"`         // 0 - monitored host; 1 - unmonitored host.
+	InventoryMode string          `json:"inventory_mode,omitempty"` // -1 - disabled; 0 - manual; 1 - automatic.
+	Groups        []HostGroupID   `json:"groups,omitempty"`         // Host groups, at least one required by host.create.
+	Templates     []TemplateID    `json:"templates,omitempty"`      // Templates to link; on update, other templates are unlinked.
+	Tags          []HostTag       `json:"tags,omitempty"`           // Host tags.
+	Interfaces    []HostInterface `json:"interfaces,omitempty"`     // Host interfaces.
+	Macros        []UserMacro     `json:"macros,omitempty"`         // User macros.
+	Inventory     HostInventory   `json:"inventory,omitempty"`      // Inventory properties.
+}
+
+// HostCreateRequest defines the JSON-RPC request structure for host.create.
+type HostCreateRequest struct {
+	JSONRPC string     `json:"jsonrpc"`
+	Method  string     `json:"method"`
+	Params  HostParams `json:"params"`
+	Auth    string     `json:"auth,omitempty"`
+	ID      int        `json:"id"`
+}
+
+// HostCreateResponse defines the JSON-RPC response structure for host.create.
+type HostCreateResponse struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Result  HostIDsResult `json:"result"`
+	ID      int           `json:"id"`
+	Error   *Error        `json:"error,omitempty"`
+}
+
+// HostCreateOption defines a function signature for options to configure a HostCreateRequest.
+type HostCreateOption func(*HostCreateRequest)
+
+// NewHostCreateRequest creates a new HostCreateRequest for the given host and applies any provided options.
+func NewHostCreateRequest(host HostParams, options ...HostCreateOption) *HostCreateRequest {
+	hcr := &HostCreateRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodHostCreate,
+		Params:  host,
+	}
+	for _, opt := range options {
+		opt(hcr)
+	}
+	return hcr
+}
+
+// WithHostCreateAuth sets the authentication token for the API request.
+func WithHostCreateAuth(token string) HostCreateOption {
+	return func(hcr *HostCreateRequest) { hcr.Auth = token }
+}
+
+// WithHostCreateID sets the ID for the API request.
+func WithHostCreateID(id int) HostCreateOption {
+	return func(hcr *HostCreateRequest) { hcr.ID = id }
+}
+
+// HostCreate sends a host.create request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) HostCreate(ctx context.Context, request *HostCreateRequest) (*HostCreateResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for host.create: %w", err)
+	}
+
+	var response HostCreateResponse
+	if err := handleRawResponse(statusCode, respBody, MethodHostCreate, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
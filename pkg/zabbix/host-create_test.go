@@ -0,0 +1,73 @@
+package zabbix_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostCreate(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, zabbix.MethodHostCreate, req["method"])
+		require.Equal(t, map[string]any{
+			"host":           "sw01",
+			"inventory_mode": "1",
+			"groups":         []any{map[string]any{"groupid": "2"}},
+			"templates":      []any{map[string]any{"templateid": "10226"}},
+			"tags":           []any{map[string]any{"tag": "env", "value": "prod"}},
+			"interfaces": []any{map[string]any{
+				"type": "2", "main": "1", "useip": "1", "ip": "10.0.0.2", "dns": "", "port": "161",
+				"details": map[string]any{"version": "2", "community": "{$SNMP_COMMUNITY}"},
+			}},
+		}, req["params"])
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"jsonrpc":"2.0","result":{"hostids":["10090"]},"id":1}`)
+	}))
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.HostCreate(context.Background(), zabbix.NewHostCreateRequest(zabbix.HostParams{
+		Host:          "sw01",
+		InventoryMode: zabbix.HostInventoryModeAutomatic,
+		Groups:        []zabbix.HostGroupID{{GroupID: "2"}},
+		Templates:     []zabbix.TemplateID{{TemplateID: "10226"}},
+		Tags:          []zabbix.HostTag{{Tag: "env", Value: "prod"}},
+		Interfaces: []zabbix.HostInterface{{
+			Type: zabbix.HostInterfaceTypeSNMP, Main: "1", UseIP: "1", IP: "10.0.0.2", Port: "161",
+			Details: &zabbix.HostInterfaceDetails{Version: zabbix.SNMPVersion2c, Community: "{$SNMP_COMMUNITY}"},
+		}},
+	}, zabbix.WithHostCreateID(1)))
+	require.NoError(t, err)
+	require.Equal(t, []string{"10090"}, resp.Result.HostIDs)
+}
+
+func TestHostUpdate(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, zabbix.MethodHostUpdate, req["method"])
+		require.Equal(t, map[string]any{"hostid": "10090", "name": "Switch 01"}, req["params"])
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params.","data":"No permissions to referred object or it does not exist!"},"id":1}`)
+	}))
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	_, err := z.HostUpdate(context.Background(), zabbix.NewHostUpdateRequest(zabbix.HostParams{HostID: "10090", Name: "Switch 01"}))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "No permissions")
+}
@@ -0,0 +1,73 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+)
+
+// MethodHostDelete is the Zabbix API method for deleting hosts.
+const MethodHostDelete = "host.delete"
+
+// HostDeleteRequest defines the JSON-RPC request structure for host.delete.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/host/delete
+type HostDeleteRequest struct {
+	JSONRPC string   `json:"jsonrpc"`
+	Method  string   `json:"method"`
+	Params  []string `json:"params"` // IDs of the hosts to delete.
+	Auth    string   `json:"auth,omitempty"`
+	ID      int      `json:"id"`
+}
+
+// HostDeleteResponse defines the JSON-RPC response structure for host.delete.
+type HostDeleteResponse struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Result  HostIDsResult `json:"result"`
+	ID      int           `json:"id"`
+	Error   *Error        `json:"error,omitempty"`
+}
+
+// HostDeleteOption defines a function signature for options to configure a HostDeleteRequest.
+type HostDeleteOption func(*HostDeleteRequest)
+
+// NewHostDeleteRequest creates a new HostDeleteRequest for the given host IDs and applies any provided options.
+func NewHostDeleteRequest(hostIDs []string, options ...HostDeleteOption) *HostDeleteRequest {
+	hdr := &HostDeleteRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodHostDelete,
+		Params:  hostIDs,
+	}
+	for _, opt := range options {
+		opt(hdr)
+	}
+	return hdr
+}
+
+// WithHostDeleteAuth sets the authentication token for the API request.
+func WithHostDeleteAuth(token string) HostDeleteOption {
+	return func(hdr *HostDeleteRequest) { hdr.Auth = token }
+}
+
+// WithHostDeleteID sets the ID for the API request.
+func WithHostDeleteID(id int) HostDeleteOption {
+	return func(hdr *HostDeleteRequest) { hdr.ID = id }
+}
+
+// HostDelete sends a host.delete request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) HostDelete(ctx context.Context, request *HostDeleteRequest) (*HostDeleteResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for host.delete: %w", err)
+	}
+
+	var response HostDeleteResponse
+	if err := handleRawResponse(statusCode, respBody, MethodHostDelete, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
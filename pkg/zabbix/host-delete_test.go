@@ -0,0 +1,33 @@
+package zabbix_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostDelete(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, zabbix.MethodHostDelete, req["method"])
+		require.Equal(t, []any{"10090", "10091"}, req["params"])
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"jsonrpc":"2.0","result":{"hostids":["10090","10091"]},"id":1}`)
+	}))
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.HostDelete(context.Background(), zabbix.NewHostDeleteRequest([]string{"10090", "10091"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"10090", "10091"}, resp.Result.HostIDs)
+}
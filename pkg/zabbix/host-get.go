@@ -0,0 +1,210 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+)
+
+// MethodHostGet is the Zabbix API method for getting hosts.
+const MethodHostGet = "host.get"
+
+// HostGetParams defines the parameters for the Zabbix host.get API call.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/host/get
+type HostGetParams struct {
+	CommonGetParams // Embeds common parameters like Output, Limit, Filter, etc.
+
+	HostIDs        []string            `json:"hostids,omitempty"`        // Return only hosts with the given host IDs.
+	GroupIDs       []string            `json:"groupids,omitempty"`       // Return only hosts that belong to the given groups.
+	TemplateIDs    []string            `json:"templateids,omitempty"`    // Return only hosts that are linked to the given templates.
+	MaintenanceIDs []string            `json:"maintenanceids,omitempty"` // Return only hosts that are affected by the given maintenances.
+	EvalType       int                 `json:"evaltype,omitempty"`       // Rules for tag searching: 0 - (default) And/Or; 2 - Or.
+	Tags           []FilterProblemTags `json:"tags,omitempty"`           // Return only hosts with given tags.
+
+	SelectHostGroups      any `json:"selectHostGroups,omitempty"`      // "extend" or array of fields
+	SelectInterfaces      any `json:"selectInterfaces,omitempty"`      // "extend" or array of fields
+	SelectParentTemplates any `json:"selectParentTemplates,omitempty"` // "extend" or array of fields
+	SelectTags            any `json:"selectTags,omitempty"`            // "extend" or array of fields
+	SelectMacros          any `json:"selectMacros,omitempty"`          // "extend" or array of fields
+	SelectInventory       any `json:"selectInventory,omitempty"`       // "extend" or array of inventory fields
+	SelectItems           any `json:"selectItems,omitempty"`           // "count" (the items themselves are not modeled)
+	SelectTriggers        any `json:"selectTriggers,omitempty"`        // "count" (the triggers themselves are not modeled)
+}
+
+// HostGetRequest defines the JSON-RPC request structure for host.get.
+type HostGetRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  HostGetParams `json:"params"`
+	Auth    string        `json:"auth,omitempty"`
+	ID      int           `json:"id"`
+}
+
+// HostGetResponse defines the JSON-RPC response structure for host.get.
+type HostGetResponse struct {
+	JSONRPC string `json:"jsonrpc"`
+	Result  []Host `json:"result"`
+	ID      int    `json:"id"`
+	Error   *Error `json:"error,omitempty"`
+}
+
+// HostGetOption defines a function signature for options to configure a HostGetRequest.
+type HostGetOption func(*HostGetRequest)
+
+// NewHostGetRequest creates a new HostGetRequest with default values and applies any provided options.
+func NewHostGetRequest(options ...HostGetOption) *HostGetRequest {
+	hgr := &HostGetRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodHostGet,
+		Params:  HostGetParams{},
+	}
+	for _, opt := range options {
+		opt(hgr)
+	}
+	return hgr
+}
+
+// --- Option functions for HostGetParams ---
+
+// WithHostGetHostIDs sets the host IDs for the request.
+func WithHostGetHostIDs(ids []string) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.HostIDs = ids }
+}
+
+// WithHostGetGroupIDs sets the host group IDs for the request.
+func WithHostGetGroupIDs(ids []string) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.GroupIDs = ids }
+}
+
+// WithHostGetTemplateIDs sets the template IDs for the request.
+func WithHostGetTemplateIDs(ids []string) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.TemplateIDs = ids }
+}
+
+// WithHostGetMaintenanceIDs sets the maintenance IDs for the request.
+func WithHostGetMaintenanceIDs(ids []string) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.MaintenanceIDs = ids }
+}
+
+// WithHostGetEvalType sets the tag evaluation type.
+func WithHostGetEvalType(evalType int) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.EvalType = evalType }
+}
+
+// WithHostGetTags sets the tags to filter hosts by.
+func WithHostGetTags(tags []FilterProblemTags) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.Tags = tags }
+}
+
+// WithHostGetSelectHostGroups sets the selectHostGroups parameter.
+func WithHostGetSelectHostGroups(query any) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.SelectHostGroups = query }
+}
+
+// WithHostGetSelectInterfaces sets the selectInterfaces parameter.
+func WithHostGetSelectInterfaces(query any) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.SelectInterfaces = query }
+}
+
+// WithHostGetSelectParentTemplates sets the selectParentTemplates parameter.
+func WithHostGetSelectParentTemplates(query any) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.SelectParentTemplates = query }
+}
+
+// WithHostGetSelectTags sets the selectTags parameter.
+func WithHostGetSelectTags(query any) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.SelectTags = query }
+}
+
+// WithHostGetSelectMacros sets the selectMacros parameter.
+func WithHostGetSelectMacros(query any) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.SelectMacros = query }
+}
+
+// WithHostGetSelectInventory sets the selectInventory parameter.
+func WithHostGetSelectInventory(query any) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.SelectInventory = query }
+}
+
+// WithHostGetSelectItems sets the selectItems parameter, "count" to populate Host.ItemCount.
+func WithHostGetSelectItems(query any) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.SelectItems = query }
+}
+
+// WithHostGetSelectTriggers sets the selectTriggers parameter, "count" to populate Host.TriggerCount.
+func WithHostGetSelectTriggers(query any) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.SelectTriggers = query }
+}
+
+// --- Option functions for CommonGetParams (embedded) ---
+
+// WithHostGetOutput sets the output parameter.
+func WithHostGetOutput(output any) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.Output = output }
+}
+
+// WithHostGetLimit sets the limit parameter.
+func WithHostGetLimit(limit int) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.Limit = limit }
+}
+
+// WithHostGetFilter sets the filter parameter.
+func WithHostGetFilter(filter map[string]any) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.Filter = filter }
+}
+
+// WithHostGetSearch sets the search parameter.
+func WithHostGetSearch(search map[string]any) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.Search = search }
+}
+
+// WithHostGetSearchByAny sets the searchByAny flag.
+func WithHostGetSearchByAny(flag bool) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.SearchByAny = flag }
+}
+
+// WithHostGetSearchWildcardsEnabled sets the searchWildcardsEnabled flag.
+func WithHostGetSearchWildcardsEnabled(flag bool) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.SearchWildcardsEnabled = flag }
+}
+
+// WithHostGetSortField sets the sortfield parameter.
+func WithHostGetSortField(sortField []string) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.SortField = sortField }
+}
+
+// WithHostGetSortOrder sets the sortorder parameter.
+func WithHostGetSortOrder(sortOrder []string) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Params.SortOrder = sortOrder }
+}
+
+// --- Option functions for request Auth and ID ---
+
+// WithHostGetAuth sets the authentication token for the API request.
+func WithHostGetAuth(token string) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.Auth = token }
+}
+
+// WithHostGetID sets the ID for the API request.
+func WithHostGetID(id int) HostGetOption {
+	return func(hgr *HostGetRequest) { hgr.ID = id }
+}
+
+// HostGet sends a host.get request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) HostGet(ctx context.Context, request *HostGetRequest) (*HostGetResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for host.get: %w", err)
+	}
+
+	var response HostGetResponse
+	if err := handleRawResponse(statusCode, respBody, MethodHostGet, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,165 @@
+package zabbix_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHostGetRequest(t *testing.T) {
+	t.Parallel()
+
+	req := zabbix.NewHostGetRequest(
+		zabbix.WithHostGetAuth("token"),
+		zabbix.WithHostGetID(3),
+		zabbix.WithHostGetOutput([]string{"hostid", "host", "name"}),
+		zabbix.WithHostGetSearch(map[string]any{"host": "web*"}),
+		zabbix.WithHostGetSearchWildcardsEnabled(true),
+		zabbix.WithHostGetGroupIDs([]string{"2"}),
+		zabbix.WithHostGetTags([]zabbix.FilterProblemTags{{Tag: "env", Value: "prod", Operator: 1}}),
+	)
+
+	require.Equal(t, zabbix.MethodHostGet, req.Method)
+	require.Equal(t, "token", req.Auth)
+	require.Equal(t, 3, req.ID)
+
+	data, err := json.Marshal(req.Params)
+	require.NoError(t, err)
+	var params map[string]any
+	require.NoError(t, json.Unmarshal(data, &params))
+	require.Equal(t, map[string]any{"host": "web*"}, params["search"])
+	require.Equal(t, true, params["searchWildcardsEnabled"])
+	require.Equal(t, []any{"2"}, params["groupids"])
+	require.NotContains(t, params, "hostids")
+}
+
+func TestHostGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req zabbix.HostGetRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, "host.get", req.Method)
+
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintln(w, `{"jsonrpc":"2.0","result":[
+				{"hostid":"10084","host":"web01","name":"Web 01","status":"0","hostgroups":[{"groupid":"2","name":"Linux servers"}]},
+				{"hostid":"10085","host":"db01","name":"","status":"1"}
+			],"id":1}`)
+		}))
+		defer ts.Close()
+
+		z := zabbix.New("user", "pass", ts.URL)
+		resp, err := z.HostGet(context.Background(), zabbix.NewHostGetRequest())
+		require.NoError(t, err)
+		require.Len(t, resp.Result, 2)
+		require.Equal(t, "Web 01", resp.Result[0].DisplayName())
+		require.True(t, resp.Result[0].IsMonitored())
+		require.Equal(t, "Linux servers", resp.Result[0].HostGroups[0].Name)
+		require.Equal(t, "db01", resp.Result[1].DisplayName())
+		require.False(t, resp.Result[1].IsMonitored())
+	})
+
+	t.Run("zabbix error", func(t *testing.T) {
+		t.Parallel()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, `{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params.","data":"Not authorised."},"id":1}`)
+		}))
+		defer ts.Close()
+
+		z := zabbix.New("user", "pass", ts.URL)
+		_, err := z.HostGet(context.Background(), zabbix.NewHostGetRequest())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Not authorised.")
+	})
+}
+
+func TestHostGetDetails(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		params := req["params"].(map[string]any)
+		require.Equal(t, "extend", params["selectInterfaces"])
+		require.Equal(t, "extend", params["selectInventory"])
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"jsonrpc":"2.0","result":[
+			{"hostid":"10084","host":"web01","inventory_mode":"0","maintenance_status":"1",
+			 "interfaces":[
+				{"interfaceid":"1","type":"1","main":"1","useip":"1","ip":"10.0.0.1","dns":"","port":"10050","available":"1","details":[]},
+				{"interfaceid":"2","type":"2","main":"1","useip":"0","ip":"","dns":"web01.example.com","port":"161","available":"2",
+				 "error":"Timeout","details":{"version":"3","bulk":"1","securityname":"zabbix","securitylevel":"2"}}
+			 ],
+			 "parentTemplates":[{"templateid":"10001","host":"Linux by Zabbix agent","name":"Linux by Zabbix agent"}],
+			 "tags":[{"tag":"env","value":"prod"}],
+			 "macros":[{"hostmacroid":"5","macro":"{$PASS}","type":"1"}],
+			 "inventory":{"os":"Debian 12","serialno_a":""}},
+			{"hostid":"10085","host":"db01","inventory":[]}
+		],"id":1}`)
+	}))
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.HostGet(context.Background(), zabbix.NewHostGetRequest(
+		zabbix.WithHostGetSelectInterfaces("extend"),
+		zabbix.WithHostGetSelectParentTemplates([]string{"templateid", "host", "name"}),
+		zabbix.WithHostGetSelectTags("extend"),
+		zabbix.WithHostGetSelectMacros("extend"),
+		zabbix.WithHostGetSelectInventory("extend"),
+	))
+	require.NoError(t, err)
+	require.Len(t, resp.Result, 2)
+
+	host := resp.Result[0]
+	require.True(t, host.InMaintenance())
+	require.Len(t, host.Interfaces, 2)
+	require.Nil(t, host.Interfaces[0].Details)
+	require.Equal(t, "agent", host.Interfaces[0].TypeName())
+	require.Equal(t, "10.0.0.1:10050", host.Interfaces[0].Address())
+	require.Equal(t, "snmp", host.Interfaces[1].TypeName())
+	require.Equal(t, "web01.example.com:161", host.Interfaces[1].Address())
+	require.Equal(t, zabbix.SNMPVersion3, host.Interfaces[1].Details.Version)
+	require.Equal(t, "Linux by Zabbix agent", host.ParentTemplates[0].Name)
+	require.Equal(t, []zabbix.HostTag{{Tag: "env", Value: "prod"}}, host.Tags)
+	require.True(t, host.Macros[0].IsSecret())
+	require.Equal(t, "Debian 12", host.Inventory["os"])
+
+	require.Nil(t, resp.Result[1].Inventory)
+	require.False(t, resp.Result[1].InMaintenance())
+}
+
+func TestHostGetCounts(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"jsonrpc":"2.0","result":[
+			{"hostid":"10084","host":"web01","items":"42","triggers":"7"},
+			{"hostid":"10085","host":"db01","items":[{"itemid":"1"},{"itemid":"2"}]}
+		],"id":1}`)
+	}))
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.HostGet(context.Background(), zabbix.NewHostGetRequest(
+		zabbix.WithHostGetSelectItems("count"),
+		zabbix.WithHostGetSelectTriggers("count"),
+	))
+	require.NoError(t, err)
+	require.Len(t, resp.Result, 2)
+	require.Equal(t, 42, resp.Result[0].ItemCount)
+	require.Equal(t, 7, resp.Result[0].TriggerCount)
+	require.Equal(t, "web01", resp.Result[0].Host)
+	require.Equal(t, 2, resp.Result[1].ItemCount)
+	require.Equal(t, 0, resp.Result[1].TriggerCount)
+}
@@ -0,0 +1,64 @@
+package zabbix_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/require"
+)
+
+// newHostMassServer returns a test server checking the method and params of host mass requests
+// and answering with the given result.
+func newHostMassServer(t *testing.T, method string, params any, result string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, method, req["method"])
+		require.Equal(t, params, req["params"])
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","result":%s,"id":1}`+"\n", result)
+	}))
+}
+
+func TestHostMassAdd(t *testing.T) {
+	t.Parallel()
+
+	ts := newHostMassServer(t, zabbix.MethodHostMassAdd, map[string]any{
+		"hosts":     []any{map[string]any{"hostid": "10084"}},
+		"templates": []any{map[string]any{"templateid": "10001"}, map[string]any{"templateid": "10002"}},
+	}, `{"hostids":["10084"]}`)
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.HostMassAdd(context.Background(), zabbix.NewHostMassAddRequest(
+		[]string{"10084"},
+		zabbix.WithHostMassAddTemplates([]string{"10001", "10002"}),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []string{"10084"}, resp.Result.HostIDs)
+}
+
+func TestHostMassRemove(t *testing.T) {
+	t.Parallel()
+
+	ts := newHostMassServer(t, zabbix.MethodHostMassRemove, map[string]any{
+		"hostids":           []any{"10084", "10085"},
+		"templateids_clear": []any{"10001"},
+	}, `{"hostids":["10084","10085"]}`)
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.HostMassRemove(context.Background(), zabbix.NewHostMassRemoveRequest(
+		[]string{"10084", "10085"},
+		zabbix.WithHostMassRemoveTemplateIDsClear([]string{"10001"}),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []string{"10084", "10085"}, resp.Result.HostIDs)
+}
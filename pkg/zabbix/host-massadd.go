@@ -0,0 +1,92 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+)
+
+// MethodHostMassAdd is the Zabbix API method for adding objects, e.g. templates, to several hosts at once.
+const MethodHostMassAdd = "host.massadd"
+
+// HostMassAddParams defines the parameters for the Zabbix host.massadd API call.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/host/massadd
+type HostMassAddParams struct {
+	Hosts     []HostID     `json:"hosts"`               // Hosts to update.
+	Templates []TemplateID `json:"templates,omitempty"` // Templates to link to the hosts.
+}
+
+// HostMassAddRequest defines the JSON-RPC request structure for host.massadd.
+type HostMassAddRequest struct {
+	JSONRPC string            `json:"jsonrpc"`
+	Method  string            `json:"method"`
+	Params  HostMassAddParams `json:"params"`
+	Auth    string            `json:"auth,omitempty"`
+	ID      int               `json:"id"`
+}
+
+// HostMassAddResponse defines the JSON-RPC response structure for host.massadd.
+type HostMassAddResponse struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Result  HostIDsResult `json:"result"`
+	ID      int           `json:"id"`
+	Error   *Error        `json:"error,omitempty"`
+}
+
+// HostMassAddOption defines a function signature for options to configure a HostMassAddRequest.
+type HostMassAddOption func(*HostMassAddRequest)
+
+// NewHostMassAddRequest creates a new HostMassAddRequest for the given host IDs and applies any provided options.
+func NewHostMassAddRequest(hostIDs []string, options ...HostMassAddOption) *HostMassAddRequest {
+	hmr := &HostMassAddRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodHostMassAdd,
+		Params:  HostMassAddParams{Hosts: make([]HostID, 0, len(hostIDs))},
+	}
+	for _, id := range hostIDs {
+		hmr.Params.Hosts = append(hmr.Params.Hosts, HostID{HostID: id})
+	}
+	for _, opt := range options {
+		opt(hmr)
+	}
+	return hmr
+}
+
+// WithHostMassAddTemplates sets the IDs of the templates to link to the hosts.
+func WithHostMassAddTemplates(templateIDs []string) HostMassAddOption {
+	return func(hmr *HostMassAddRequest) {
+		hmr.Params.Templates = make([]TemplateID, 0, len(templateIDs))
+		for _, id := range templateIDs {
+			hmr.Params.Templates = append(hmr.Params.Templates, TemplateID{TemplateID: id})
+		}
+	}
+}
+
+// WithHostMassAddAuth sets the authentication token for the API request.
+func WithHostMassAddAuth(token string) HostMassAddOption {
+	return func(hmr *HostMassAddRequest) { hmr.Auth = token }
+}
+
+// WithHostMassAddID sets the ID for the API request.
+func WithHostMassAddID(id int) HostMassAddOption {
+	return func(hmr *HostMassAddRequest) { hmr.ID = id }
+}
+
+// HostMassAdd sends a host.massadd request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) HostMassAdd(ctx context.Context, request *HostMassAddRequest) (*HostMassAddResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for host.massadd: %w", err)
+	}
+
+	var response HostMassAddResponse
+	if err := handleRawResponse(statusCode, respBody, MethodHostMassAdd, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,92 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+)
+
+// MethodHostMassRemove is the Zabbix API method for removing objects, e.g. templates, from several hosts at once.
+const MethodHostMassRemove = "host.massremove"
+
+// HostMassRemoveParams defines the parameters for the Zabbix host.massremove API call.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/host/massremove
+type HostMassRemoveParams struct {
+	HostIDs          []string `json:"hostids"`                     // Hosts to update.
+	TemplateIDs      []string `json:"templateids,omitempty"`       // Templates to unlink from the hosts.
+	TemplateIDsClear []string `json:"templateids_clear,omitempty"` // Templates to unlink and clear from the hosts.
+}
+
+// HostMassRemoveRequest defines the JSON-RPC request structure for host.massremove.
+type HostMassRemoveRequest struct {
+	JSONRPC string               `json:"jsonrpc"`
+	Method  string               `json:"method"`
+	Params  HostMassRemoveParams `json:"params"`
+	Auth    string               `json:"auth,omitempty"`
+	ID      int                  `json:"id"`
+}
+
+// HostMassRemoveResponse defines the JSON-RPC response structure for host.massremove.
+type HostMassRemoveResponse struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Result  HostIDsResult `json:"result"`
+	ID      int           `json:"id"`
+	Error   *Error        `json:"error,omitempty"`
+}
+
+// HostMassRemoveOption defines a function signature for options to configure a HostMassRemoveRequest.
+type HostMassRemoveOption func(*HostMassRemoveRequest)
+
+// NewHostMassRemoveRequest creates a new HostMassRemoveRequest for the given host IDs and applies any provided options.
+func NewHostMassRemoveRequest(hostIDs []string, options ...HostMassRemoveOption) *HostMassRemoveRequest {
+	hmr := &HostMassRemoveRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodHostMassRemove,
+		Params:  HostMassRemoveParams{HostIDs: hostIDs},
+	}
+	for _, opt := range options {
+		opt(hmr)
+	}
+	return hmr
+}
+
+// WithHostMassRemoveTemplateIDs sets the IDs of the templates to unlink from the hosts. The
+// entities inherited from the templates are kept on the hosts.
+func WithHostMassRemoveTemplateIDs(templateIDs []string) HostMassRemoveOption {
+	return func(hmr *HostMassRemoveRequest) { hmr.Params.TemplateIDs = templateIDs }
+}
+
+// WithHostMassRemoveTemplateIDsClear sets the IDs of the templates to unlink and clear from the
+// hosts: the entities inherited from the templates are deleted.
+func WithHostMassRemoveTemplateIDsClear(templateIDs []string) HostMassRemoveOption {
+	return func(hmr *HostMassRemoveRequest) { hmr.Params.TemplateIDsClear = templateIDs }
+}
+
+// WithHostMassRemoveAuth sets the authentication token for the API request.
+func WithHostMassRemoveAuth(token string) HostMassRemoveOption {
+	return func(hmr *HostMassRemoveRequest) { hmr.Auth = token }
+}
+
+// WithHostMassRemoveID sets the ID for the API request.
+func WithHostMassRemoveID(id int) HostMassRemoveOption {
+	return func(hmr *HostMassRemoveRequest) { hmr.ID = id }
+}
+
+// HostMassRemove sends a host.massremove request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) HostMassRemove(ctx context.Context, request *HostMassRemoveRequest) (*HostMassRemoveResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for host.massremove: %w", err)
+	}
+
+	var response HostMassRemoveResponse
+	if err := handleRawResponse(statusCode, respBody, MethodHostMassRemove, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
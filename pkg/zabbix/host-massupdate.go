@@ -0,0 +1,93 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+)
+
+// MethodHostMassUpdate is the Zabbix API method for updating several hosts at once.
+const MethodHostMassUpdate = "host.massupdate"
+
+// HostMassUpdateParams defines the parameters for the Zabbix host.massupdate API call.
+// Only the properties that are set are replaced on every host.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/host/massupdate
+type HostMassUpdateParams struct {
+	Hosts  []HostID `json:"hosts"`            // Hosts to update.
+	Status string   `json:"status,omitempty"` // 0 - monitored host; 1 - unmonitored host.
+}
+
+// HostMassUpdateRequest defines the JSON-RPC request structure for host.massupdate.
+type HostMassUpdateRequest struct {
+	JSONRPC string               `json:"jsonrpc"`
+	Method  string               `json:"method"`
+	Params  HostMassUpdateParams `json:"params"`
+	Auth    string               `json:"auth,omitempty"`
+	ID      int                  `json:"id"`
+}
+
+// HostMassUpdateResponse defines the JSON-RPC response structure for host.massupdate.
+type HostMassUpdateResponse struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Result  HostIDsResult `json:"result"`
+	ID      int           `json:"id"`
+	Error   *Error        `json:"error,omitempty"`
+}
+
+// HostIDsResult contains the 'hostids' returned by the host methods.
+type HostIDsResult struct {
+	HostIDs []string `json:"hostids"`
+}
+
+// HostMassUpdateOption defines a function signature for options to configure a HostMassUpdateRequest.
+type HostMassUpdateOption func(*HostMassUpdateRequest)
+
+// NewHostMassUpdateRequest creates a new HostMassUpdateRequest for the given host IDs and applies any provided options.
+func NewHostMassUpdateRequest(hostIDs []string, options ...HostMassUpdateOption) *HostMassUpdateRequest {
+	hmr := &HostMassUpdateRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodHostMassUpdate,
+		Params:  HostMassUpdateParams{Hosts: make([]HostID, 0, len(hostIDs))},
+	}
+	for _, id := range hostIDs {
+		hmr.Params.Hosts = append(hmr.Params.Hosts, HostID{HostID: id})
+	}
+	for _, opt := range options {
+		opt(hmr)
+	}
+	return hmr
+}
+
+// WithHostMassUpdateStatus sets the status of the hosts: HostStatusMonitored or HostStatusUnmonitored.
+func WithHostMassUpdateStatus(status string) HostMassUpdateOption {
+	return func(hmr *HostMassUpdateRequest) { hmr.Params.Status = status }
+}
+
+// WithHostMassUpdateAuth sets the authentication token for the API request.
+func WithHostMassUpdateAuth(token string) HostMassUpdateOption {
+	return func(hmr *HostMassUpdateRequest) { hmr.Auth = token }
+}
+
+// WithHostMassUpdateID sets the ID for the API request.
+func WithHostMassUpdateID(id int) HostMassUpdateOption {
+	return func(hmr *HostMassUpdateRequest) { hmr.ID = id }
+}
+
+// HostMassUpdate sends a host.massupdate request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) HostMassUpdate(ctx context.Context, request *HostMassUpdateRequest) (*HostMassUpdateResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for host.massupdate: %w", err)
+	}
+
+	var response HostMassUpdateResponse
+	if err := handleRawResponse(statusCode, respBody, MethodHostMassUpdate, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,39 @@
+package zabbix_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostMassUpdate(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, zabbix.MethodHostMassUpdate, req["method"])
+		require.Equal(t, map[string]any{
+			"hosts":  []any{map[string]any{"hostid": "10084"}, map[string]any{"hostid": "10085"}},
+			"status": "1",
+		}, req["params"])
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"jsonrpc":"2.0","result":{"hostids":["10084","10085"]},"id":1}`)
+	}))
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.HostMassUpdate(context.Background(), zabbix.NewHostMassUpdateRequest(
+		[]string{"10084", "10085"},
+		zabbix.WithHostMassUpdateStatus(zabbix.HostStatusUnmonitored),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []string{"10084", "10085"}, resp.Result.HostIDs)
+}
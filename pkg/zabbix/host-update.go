@@ -0,0 +1,74 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+)
+
+// MethodHostUpdate is the Zabbix API method for updating hosts.
+const MethodHostUpdate = "host.update"
+
+// HostUpdateRequest defines the JSON-RPC request structure for host.update.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/host/update
+type HostUpdateRequest struct {
+	JSONRPC string     `json:"jsonrpc"`
+	Method  string     `json:"method"`
+	Params  HostParams `json:"params"` // 'hostid' is required, the other properties that are set are replaced.
+	Auth    string     `json:"auth,omitempty"`
+	ID      int        `json:"id"`
+}
+
+// HostUpdateResponse defines the JSON-RPC response structure for host.update.
+type HostUpdateResponse struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Result  HostIDsResult `json:"result"`
+	ID      int           `json:"id"`
+	Error   *Error        `json:"error,omitempty"`
+}
+
+// HostUpdateOption defines a function signature for options to configure a HostUpdateRequest.
+type HostUpdateOption func(*HostUpdateRequest)
+
+// NewHostUpdateRequest creates a new HostUpdateRequest for the given host and applies any provided options.
+// host.HostID must be set.
+func NewHostUpdateRequest(host HostParams, options ...HostUpdateOption) *HostUpdateRequest {
+	hur := &HostUpdateRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodHostUpdate,
+		Params:  host,
+	}
+	for _, opt := range options {
+		opt(hur)
+	}
+	return hur
+}
+
+// WithHostUpdateAuth sets the authentication token for the API request.
+func WithHostUpdateAuth(token string) HostUpdateOption {
+	return func(hur *HostUpdateRequest) { hur.Auth = token }
+}
+
+// WithHostUpdateID sets the ID for the API request.
+func WithHostUpdateID(id int) HostUpdateOption {
+	return func(hur *HostUpdateRequest) { hur.ID = id }
+}
+
+// HostUpdate sends a host.update request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) HostUpdate(ctx context.Context, request *HostUpdateRequest) (*HostUpdateResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for host.update: %w", err)
+	}
+
+	var response HostUpdateResponse
+	if err := handleRawResponse(statusCode, respBody, MethodHostUpdate, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
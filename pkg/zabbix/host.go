@@ -0,0 +1,140 @@
+package zabbix
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Host status values.
+const (
+	// HostStatusMonitored is the status of a monitored (enabled) host.
+	HostStatusMonitored = "0"
+	// HostStatusUnmonitored is the status of an unmonitored (disabled) host.
+	HostStatusUnmonitored = "1"
+)
+
+// Host inventory modes.
+const (
+	// HostInventoryModeDisabled disables the inventory of the host.
+	HostInventoryModeDisabled = "-1"
+	// HostInventoryModeManual lets users fill in the inventory of the host.
+	HostInventoryModeManual = "0"
+	// HostInventoryModeAutomatic fills in the inventory of the host from item values.
+	HostInventoryModeAutomatic = "1"
+)
+
+// Host represents the Zabbix host API object.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/host/object#host
+type Host struct {
+	HostID            string `json:"hostid,omitempty"`
+	Host              string `json:"host"`                         // Technical name of the host.
+	Name              string `json:"name,omitempty"`               // Visible name of the host.
+	Description       string `json:"description,omitempty"`        // Description of the host.
+	Status            string `json:"status,omitempty"`             // 0 - (default) monitored host; 1 - unmonitored host.
+	InventoryMode     string `json:"inventory_mode,omitempty"`     // -1 - disabled; 0 - (default) manual; 1 - automatic.
+	MaintenanceStatus string `json:"maintenance_status,omitempty"` // Readonly. 0 - (default) no maintenance; 1 - maintenance in effect.
+	ActiveAvailable   string `json:"active_available,omitempty"`   // Readonly. Availability of active agent checks: 0 - unknown; 1 - available; 2 - not available.
+
+	// Fields populated by select queries
+	HostGroups      []HostGroup     `json:"hostgroups,omitempty"`      // Populated by selectHostGroups
+	Interfaces      []HostInterface `json:"interfaces,omitempty"`      // Populated by selectInterfaces
+	ParentTemplates []Template      `json:"parentTemplates,omitempty"` // Populated by selectParentTemplates
+	Tags            []HostTag       `json:"tags,omitempty"`            // Populated by selectTags
+	Macros          []UserMacro     `json:"macros,omitempty"`          // Populated by selectMacros
+	Inventory       HostInventory   `json:"inventory,omitempty"`       // Populated by selectInventory
+
+	// ItemCount and TriggerCount are the numbers of items and triggers of the host, populated by
+	// selectItems and selectTriggers "count".
+	ItemCount    int `json:"-"`
+	TriggerCount int `json:"-"`
+}
+
+// UnmarshalJSON is a custom unmarshaler for Host to handle 'items' and 'triggers' returned,
+// with selectItems and selectTriggers "count", as numbers of objects.
+func (h *Host) UnmarshalJSON(data []byte) error {
+	type alias Host
+	aux := struct {
+		*alias
+		Items    json.RawMessage `json:"items"`
+		Triggers json.RawMessage `json:"triggers"`
+	}{alias: (*alias)(h)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return fmt.Errorf("cannot unmarshal host: %w", err)
+	}
+
+	var err error
+	if h.ItemCount, err = unmarshalObjectCount(aux.Items); err != nil {
+		return fmt.Errorf("cannot unmarshal host items: %w", err)
+	}
+	if h.TriggerCount, err = unmarshalObjectCount(aux.Triggers); err != nil {
+		return fmt.Errorf("cannot unmarshal host triggers: %w", err)
+	}
+	return nil
+}
+
+// unmarshalObjectCount returns the number of objects of a select query: either a count
+// returned as a string, or the length of the returned array.
+func unmarshalObjectCount(data json.RawMessage) (int, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return 0, nil
+	}
+	if data[0] == '"' {
+		var count string
+		if err := json.Unmarshal(data, &count); err != nil {
+			return 0, fmt.Errorf("cannot unmarshal count: %w", err)
+		}
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return 0, fmt.Errorf("invalid count %q: %w", count, err)
+		}
+		return n, nil
+	}
+	var objects []json.RawMessage
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return 0, fmt.Errorf("cannot unmarshal objects: %w", err)
+	}
+	return len(objects), nil
+}
+
+// HostTag represents a tag of a host.
+type HostTag struct {
+	Tag   string `json:"tag"`
+	Value string `json:"value"`
+}
+
+// HostInventory holds the inventory fields of a host, by property name (e.g. "os", "serialno_a").
+type HostInventory map[string]string
+
+// UnmarshalJSON is a custom unmarshaler for HostInventory, as the API returns an empty array
+// instead of an object for hosts without inventory.
+func (hi *HostInventory) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '[' {
+		*hi = nil
+		return nil
+	}
+	var fields map[string]string
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("cannot unmarshal host inventory: %w", err)
+	}
+	*hi = fields
+	return nil
+}
+
+// IsMonitored returns true if the host is monitored (enabled).
+func (h *Host) IsMonitored() bool {
+	return h.Status == "" || h.Status == HostStatusMonitored
+}
+
+// InMaintenance returns true if a maintenance is in effect for the host.
+func (h *Host) InMaintenance() bool {
+	return h.MaintenanceStatus == "1"
+}
+
+// DisplayName returns the visible name of the host, or its technical name if it has none.
+func (h *Host) DisplayName() string {
+	if h.Name != "" {
+		return h.Name
+	}
+	return h.Host
+}
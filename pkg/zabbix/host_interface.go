@@ -0,0 +1,113 @@
+package zabbix
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Host interface types.
+const (
+	HostInterfaceTypeAgent = "1"
+	HostInterfaceTypeSNMP  = "2"
+	HostInterfaceTypeIPMI  = "3"
+	HostInterfaceTypeJMX   = "4"
+)
+
+// Host interface availability values.
+const (
+	HostInterfaceAvailabilityUnknown     = "0"
+	HostInterfaceAvailabilityAvailable   = "1"
+	HostInterfaceAvailabilityUnavailable = "2"
+)
+
+// SNMP versions of SNMP interfaces.
+const (
+	SNMPVersion1  = "1"
+	SNMPVersion2c = "2"
+	SNMPVersion3  = "3"
+)
+
+// hostInterfaceTypeNames are the names of the host interface types.
+var hostInterfaceTypeNames = map[string]string{
+	HostInterfaceTypeAgent: "agent",
+	HostInterfaceTypeSNMP:  "snmp",
+	HostInterfaceTypeIPMI:  "ipmi",
+	HostInterfaceTypeJMX:   "jmx",
+}
+
+// HostInterface represents the Zabbix host interface API object.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/hostinterface/object
+type HostInterface struct {
+	InterfaceID string                `json:"interfaceid,omitempty"`
+	HostID      string                `json:"hostid,omitempty"`
+	Type        string                `json:"type"`                // 1 - agent; 2 - SNMP; 3 - IPMI; 4 - JMX.
+	Main        string                `json:"main"`                // Whether the interface is the default one of its type: 0 - no; 1 - yes.
+	UseIP       string                `json:"useip"`               // Whether to connect with the IP address: 0 - use the DNS name; 1 - use the IP address.
+	IP          string                `json:"ip"`                  // IP address, can be empty when connecting with the DNS name.
+	DNS         string                `json:"dns"`                 // DNS name, can be empty when connecting with the IP address.
+	Port        string                `json:"port"`                // Port number, may contain user macros.
+	Available   string                `json:"available,omitempty"` // Readonly. 0 - unknown; 1 - available; 2 - unavailable.
+	Error       string                `json:"error,omitempty"`     // Readonly. Error text when the interface is unavailable.
+	Details     *HostInterfaceDetails `json:"details,omitempty"`   // SNMP details, required for SNMP interfaces.
+}
+
+// HostInterfaceDetails holds the SNMP details of a host interface.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/hostinterface/object#details
+type HostInterfaceDetails struct {
+	Version        string `json:"version"`                   // SNMP version: 1, 2 (v2c) or 3.
+	Bulk           string `json:"bulk,omitempty"`            // Whether to use bulk requests: 0 - no; 1 - (default) yes.
+	Community      string `json:"community,omitempty"`       // SNMP community, for v1 and v2c.
+	MaxRepetitions string `json:"max_repetitions,omitempty"` // Max repetition value for bulk requests (v2c and v3).
+	SecurityName   string `json:"securityname,omitempty"`    // SNMPv3 security name.
+	SecurityLevel  string `json:"securitylevel,omitempty"`   // SNMPv3 security level: 0 - noAuthNoPriv; 1 - authNoPriv; 2 - authPriv.
+	AuthPassphrase string `json:"authpassphrase,omitempty"`  // SNMPv3 authentication passphrase.
+	PrivPassphrase string `json:"privpassphrase,omitempty"`  // SNMPv3 privacy passphrase.
+	AuthProtocol   string `json:"authprotocol,omitempty"`    // SNMPv3 authentication protocol: 0 - MD5; 1 - SHA1; 2 - SHA224; 3 - SHA256; 4 - SHA384; 5 - SHA512.
+	PrivProtocol   string `json:"privprotocol,omitempty"`    // SNMPv3 privacy protocol: 0 - DES; 1 - AES128; 2 - AES192; 3 - AES256; 4 - AES192C; 5 - AES256C.
+	ContextName    string `json:"contextname,omitempty"`     // SNMPv3 context name.
+}
+
+// UnmarshalJSON is a custom unmarshaler for HostInterface, as the API returns an empty array
+// instead of an object for the details of interfaces other than SNMP.
+func (hi *HostInterface) UnmarshalJSON(data []byte) error {
+	type alias HostInterface
+	aux := struct {
+		*alias
+		Details json.RawMessage `json:"details"`
+	}{alias: (*alias)(hi)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return fmt.Errorf("cannot unmarshal host interface: %w", err)
+	}
+
+	hi.Details = nil
+	if len(aux.Details) == 0 || aux.Details[0] != '{' {
+		return nil
+	}
+	hi.Details = &HostInterfaceDetails{}
+	if err := json.Unmarshal(aux.Details, hi.Details); err != nil {
+		return fmt.Errorf("cannot unmarshal host interface details: %w", err)
+	}
+	return nil
+}
+
+// TypeName returns the name of the interface type: agent, snmp, ipmi or jmx.
+func (hi *HostInterface) TypeName() string {
+	if name, ok := hostInterfaceTypeNames[hi.Type]; ok {
+		return name
+	}
+	return "type " + hi.Type
+}
+
+// Address returns the address used to connect to the interface, "ip:port" or "dns:port".
+func (hi *HostInterface) Address() string {
+	address := hi.IP
+	if hi.UseIP == "0" {
+		address = hi.DNS
+	}
+	return address + ":" + hi.Port
+}
+
+// IsMain returns true if the interface is the default interface of its type.
+func (hi *HostInterface) IsMain() bool {
+	return hi.Main == "1"
+}
@@ -0,0 +1,56 @@
+package zabbix
+
+// HostGroupDeleteRequest represents the JSON-RPC request for hostgroup.delete.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/hostgroup/delete
+type HostGroupDeleteRequest struct {
+	JSONRPC string   `json:"jsonrpc"`
+	Method  string   `json:"method"`
+	Params  []string `json:"params"` // IDs of the host groups to delete.
+	Auth    string   `json:"auth,omitempty"`
+	ID      int      `json:"id"`
+}
+
+// HostGroupDeleteResponseData contains the 'groupids' from the hostgroup.delete response.
+type HostGroupDeleteResponseData struct {
+	GroupIDs []string `json:"groupids"`
+}
+
+// HostGroupDeleteResponse represents the JSON-RPC response for hostgroup.delete.
+type HostGroupDeleteResponse struct {
+	JSONRPC string                      `json:"jsonrpc"`
+	Result  HostGroupDeleteResponseData `json:"result"`
+	ID      int                         `json:"id"`
+	Error   *Error                      `json:"error,omitempty"`
+}
+
+// HostGroupDeleteOption defines a function signature for options to configure a HostGroupDeleteRequest.
+type HostGroupDeleteOption func(*HostGroupDeleteRequest)
+
+// NewHostGroupDeleteRequest creates a new HostGroupDeleteRequest for the given host group IDs
+// and applies any provided options.
+func NewHostGroupDeleteRequest(groupIDs []string, options ...HostGroupDeleteOption) *HostGroupDeleteRequest {
+	hdr := &HostGroupDeleteRequest{
+		JSONRPC: JSONRPC,
+		Method:  "hostgroup.delete",
+		Params:  groupIDs,
+	}
+
+	for _, opt := range options {
+		opt(hdr)
+	}
+	return hdr
+}
+
+// WithHostGroupDeleteAuth sets the authentication token for the API request.
+func WithHostGroupDeleteAuth(token string) HostGroupDeleteOption {
+	return func(hdr *HostGroupDeleteRequest) {
+		hdr.Auth = token
+	}
+}
+
+// WithHostGroupDeleteID sets the ID for the API request.
+func WithHostGroupDeleteID(id int) HostGroupDeleteOption {
+	return func(hdr *HostGroupDeleteRequest) {
+		hdr.ID = id
+	}
+}
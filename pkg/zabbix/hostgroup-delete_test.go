@@ -0,0 +1,90 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHostGroupDelete tests deleting host groups by ID
+func TestHostGroupDelete(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request HostGroupDeleteRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		assert.NoError(t, err)
+
+		assert.Equal(t, JSONRPC, request.JSONRPC)
+		assert.Equal(t, "hostgroup.delete", request.Method)
+		assert.Equal(t, "test-auth-token", request.Auth)
+		assert.Equal(t, []string{"101", "102"}, request.Params)
+
+		response := HostGroupDeleteResponse{
+			JSONRPC: JSONRPC,
+			Result:  HostGroupDeleteResponseData{GroupIDs: request.Params},
+			ID:      request.ID,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := Client{
+		APIEndpoint: server.URL,
+		auth:        "test-auth-token",
+		client:      &http.Client{},
+	}
+
+	request := NewHostGroupDeleteRequest([]string{"101", "102"},
+		WithHostGroupDeleteAuth("test-auth-token"),
+		WithHostGroupDeleteID(1),
+	)
+
+	response, err := client.HostGroupDelete(context.Background(), request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"101", "102"}, response.Result.GroupIDs)
+}
+
+// TestHostGroupDeleteError tests error handling when a host would be left without group
+func TestHostGroupDeleteError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := HostGroupDeleteResponse{
+			JSONRPC: JSONRPC,
+			Error: &Error{
+				Code:    -32500,
+				Message: "Application error.",
+				Data:    "Host \"web01\" cannot be without host group.",
+			},
+			ID: 1,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := Client{
+		APIEndpoint: server.URL,
+		auth:        "test-auth-token",
+		client:      &http.Client{},
+	}
+
+	response, err := client.HostGroupDelete(context.Background(), NewHostGroupDeleteRequest([]string{"101"}))
+
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	zbxErr, ok := err.(*Error)
+	assert.True(t, ok, "error should be of type *zabbix.Error")
+	assert.Contains(t, zbxErr.Data, "without host group")
+}
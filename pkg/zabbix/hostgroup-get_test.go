@@ -637,3 +637,32 @@ func TestHostGroupGetNewGetAllHostGroupsRequest(t *testing.T) {
 	assert.NotNil(t, response)
 	assert.Equal(t, 2, len(response.Result))
 }
+
+// TestHostGroupUnmarshalHosts tests decoding hosts returned as an array or as a count
+func TestHostGroupUnmarshalHosts(t *testing.T) {
+	t.Parallel()
+
+	var groups []HostGroup
+	err := json.Unmarshal([]byte(`[
+		{"groupid": "2", "name": "Linux servers", "flags": "0", "hosts": [{"hostid": "10084", "host": "web01"}]},
+		{"groupid": "7", "name": "Discovered/VMs", "flags": "4", "hosts": "12"},
+		{"groupid": "8", "name": "Empty"}
+	]`), &groups)
+	assert.NoError(t, err)
+	assert.Len(t, groups, 3)
+
+	assert.Equal(t, "Linux servers", groups[0].Name)
+	assert.Equal(t, []Host{{HostID: "10084", Host: "web01"}}, groups[0].Hosts)
+	assert.Equal(t, 1, groups[0].HostCount)
+	assert.False(t, groups[0].IsDiscovered())
+
+	assert.Equal(t, "7", groups[1].GroupID)
+	assert.Nil(t, groups[1].Hosts)
+	assert.Equal(t, 12, groups[1].HostCount)
+	assert.True(t, groups[1].IsDiscovered())
+
+	assert.Equal(t, 0, groups[2].HostCount)
+
+	err = json.Unmarshal([]byte(`{"groupid": "2", "name": "x", "hosts": "many"}`), &groups[0])
+	assert.Error(t, err)
+}
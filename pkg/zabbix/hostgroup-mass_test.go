@@ -0,0 +1,81 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newHostGroupMassServer returns a server checking the method and params of mass requests
+// and answering with the given group IDs.
+func newHostGroupMassServer(t *testing.T, method string, params any) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var raw map[string]any
+		err := json.NewDecoder(r.Body).Decode(&raw)
+		assert.NoError(t, err)
+		assert.Equal(t, method, raw["method"])
+		assert.Equal(t, "test-auth-token", raw["auth"])
+		assert.Equal(t, params, raw["params"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"jsonrpc":"2.0","result":{"groupids":["11"]},"id":1}`))
+	}))
+}
+
+// TestHostGroupMassAdd tests adding hosts to host groups
+func TestHostGroupMassAdd(t *testing.T) {
+	t.Parallel()
+
+	server := newHostGroupMassServer(t, "hostgroup.massadd", map[string]any{
+		"groups": []any{map[string]any{"groupid": "11"}},
+		"hosts":  []any{map[string]any{"hostid": "10084"}, map[string]any{"hostid": "10085"}},
+	})
+	defer server.Close()
+
+	client := Client{
+		APIEndpoint: server.URL,
+		auth:        "test-auth-token",
+		client:      &http.Client{},
+	}
+
+	request := NewHostGroupMassAddRequest([]string{"11"}, []string{"10084", "10085"},
+		WithHostGroupMassAddAuth("test-auth-token"),
+		WithHostGroupMassAddID(1),
+	)
+	response, err := client.HostGroupMassAdd(context.Background(), request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"11"}, response.Result.GroupIDs)
+}
+
+// TestHostGroupMassRemove tests removing hosts from host groups
+func TestHostGroupMassRemove(t *testing.T) {
+	t.Parallel()
+
+	server := newHostGroupMassServer(t, "hostgroup.massremove", map[string]any{
+		"groupids": []any{"11"},
+		"hostids":  []any{"10084"},
+	})
+	defer server.Close()
+
+	client := Client{
+		APIEndpoint: server.URL,
+		auth:        "test-auth-token",
+		client:      &http.Client{},
+	}
+
+	request := NewHostGroupMassRemoveRequest([]string{"11"}, []string{"10084"},
+		WithHostGroupMassRemoveAuth("test-auth-token"),
+		WithHostGroupMassRemoveID(1),
+	)
+	response, err := client.HostGroupMassRemove(context.Background(), request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"11"}, response.Result.GroupIDs)
+}
@@ -0,0 +1,76 @@
+package zabbix
+
+// HostGroupMassAddParams defines the parameters for the hostgroup.massadd API call.
+type HostGroupMassAddParams struct {
+	Groups []HostGroupID `json:"groups"` // Host groups to add the hosts to.
+	Hosts  []HostID      `json:"hosts"`  // Hosts to add to the host groups.
+}
+
+// HostGroupID references a host group by ID in mass update requests.
+type HostGroupID struct {
+	GroupID string `json:"groupid"`
+}
+
+// HostID references a host by ID in mass update requests.
+type HostID struct {
+	HostID string `json:"hostid"`
+}
+
+// HostGroupMassAddRequest represents the JSON-RPC request for hostgroup.massadd.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/hostgroup/massadd
+type HostGroupMassAddRequest struct {
+	JSONRPC string                 `json:"jsonrpc"`
+	Method  string                 `json:"method"`
+	Params  HostGroupMassAddParams `json:"params"`
+	Auth    string                 `json:"auth,omitempty"`
+	ID      int                    `json:"id"`
+}
+
+// HostGroupMassAddResponse represents the JSON-RPC response for hostgroup.massadd.
+type HostGroupMassAddResponse struct {
+	JSONRPC string                      `json:"jsonrpc"`
+	Result  HostGroupUpdateResponseData `json:"result"`
+	ID      int                         `json:"id"`
+	Error   *Error                      `json:"error,omitempty"`
+}
+
+// HostGroupMassAddOption defines a function signature for options to configure a HostGroupMassAddRequest.
+type HostGroupMassAddOption func(*HostGroupMassAddRequest)
+
+// NewHostGroupMassAddRequest creates a new HostGroupMassAddRequest adding the hosts hostIDs to the
+// host groups groupIDs, and applies any provided options.
+func NewHostGroupMassAddRequest(groupIDs, hostIDs []string, options ...HostGroupMassAddOption) *HostGroupMassAddRequest {
+	hmr := &HostGroupMassAddRequest{
+		JSONRPC: JSONRPC,
+		Method:  "hostgroup.massadd",
+		Params: HostGroupMassAddParams{
+			Groups: make([]HostGroupID, 0, len(groupIDs)),
+			Hosts:  make([]HostID, 0, len(hostIDs)),
+		},
+	}
+	for _, id := range groupIDs {
+		hmr.Params.Groups = append(hmr.Params.Groups, HostGroupID{GroupID: id})
+	}
+	for _, id := range hostIDs {
+		hmr.Params.Hosts = append(hmr.Params.Hosts, HostID{HostID: id})
+	}
+
+	for _, opt := range options {
+		opt(hmr)
+	}
+	return hmr
+}
+
+// WithHostGroupMassAddAuth sets the authentication token for the API request.
+func WithHostGroupMassAddAuth(token string) HostGroupMassAddOption {
+	return func(hmr *HostGroupMassAddRequest) {
+		hmr.Auth = token
+	}
+}
+
+// WithHostGroupMassAddID sets the ID for the API request.
+func WithHostGroupMassAddID(id int) HostGroupMassAddOption {
+	return func(hmr *HostGroupMassAddRequest) {
+		hmr.ID = id
+	}
+}
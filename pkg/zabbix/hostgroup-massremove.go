@@ -0,0 +1,57 @@
+package zabbix
+
+// HostGroupMassRemoveParams defines the parameters for the hostgroup.massremove API call.
+type HostGroupMassRemoveParams struct {
+	GroupIDs []string `json:"groupids"` // Host groups to remove the hosts from.
+	HostIDs  []string `json:"hostids"`  // Hosts to remove from the host groups.
+}
+
+// HostGroupMassRemoveRequest represents the JSON-RPC request for hostgroup.massremove.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/hostgroup/massremove
+type HostGroupMassRemoveRequest struct {
+	JSONRPC string                    `json:"jsonrpc"`
+	Method  string                    `json:"method"`
+	Params  HostGroupMassRemoveParams `json:"params"`
+	Auth    string                    `json:"auth,omitempty"`
+	ID      int                       `json:"id"`
+}
+
+// HostGroupMassRemoveResponse represents the JSON-RPC response for hostgroup.massremove.
+type HostGroupMassRemoveResponse struct {
+	JSONRPC string                      `json:"jsonrpc"`
+	Result  HostGroupUpdateResponseData `json:"result"`
+	ID      int                         `json:"id"`
+	Error   *Error                      `json:"error,omitempty"`
+}
+
+// HostGroupMassRemoveOption defines a function signature for options to configure a HostGroupMassRemoveRequest.
+type HostGroupMassRemoveOption func(*HostGroupMassRemoveRequest)
+
+// NewHostGroupMassRemoveRequest creates a new HostGroupMassRemoveRequest removing the hosts hostIDs
+// from the host groups groupIDs, and applies any provided options.
+func NewHostGroupMassRemoveRequest(groupIDs, hostIDs []string, options ...HostGroupMassRemoveOption) *HostGroupMassRemoveRequest {
+	hmr := &HostGroupMassRemoveRequest{
+		JSONRPC: JSONRPC,
+		Method:  "hostgroup.massremove",
+		Params:  HostGroupMassRemoveParams{GroupIDs: groupIDs, HostIDs: hostIDs},
+	}
+
+	for _, opt := range options {
+		opt(hmr)
+	}
+	return hmr
+}
+
+// WithHostGroupMassRemoveAuth sets the authentication token for the API request.
+func WithHostGroupMassRemoveAuth(token string) HostGroupMassRemoveOption {
+	return func(hmr *HostGroupMassRemoveRequest) {
+		hmr.Auth = token
+	}
+}
+
+// WithHostGroupMassRemoveID sets the ID for the API request.
+func WithHostGroupMassRemoveID(id int) HostGroupMassRemoveOption {
+	return func(hmr *HostGroupMassRemoveRequest) {
+		hmr.ID = id
+	}
+}
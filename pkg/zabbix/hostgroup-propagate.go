@@ -0,0 +1,78 @@
+package zabbix
+
+// HostGroupPropagateMinVersion is the first Zabbix version supporting hostgroup.propagate.
+var HostGroupPropagateMinVersion = APIVersion{Major: 6, Minor: 2}
+
+// HostGroupPropagateParams defines the parameters for the hostgroup.propagate API call.
+type HostGroupPropagateParams struct {
+	Groups      []HostGroupID `json:"groups"`      // Host groups whose settings are propagated to their subgroups.
+	Permissions bool          `json:"permissions"` // Propagate the permissions of the user groups.
+	TagFilters  bool          `json:"tag_filters"` // Propagate the tag filters of the user groups.
+}
+
+// HostGroupPropagateRequest represents the JSON-RPC request for hostgroup.propagate.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/hostgroup/propagate
+type HostGroupPropagateRequest struct {
+	JSONRPC string                   `json:"jsonrpc"`
+	Method  string                   `json:"method"`
+	Params  HostGroupPropagateParams `json:"params"`
+	Auth    string                   `json:"auth,omitempty"`
+	ID      int                      `json:"id"`
+}
+
+// HostGroupPropagateResponse represents the JSON-RPC response for hostgroup.propagate.
+type HostGroupPropagateResponse struct {
+	JSONRPC string                      `json:"jsonrpc"`
+	Result  HostGroupUpdateResponseData `json:"result"`
+	ID      int                         `json:"id"`
+	Error   *Error                      `json:"error,omitempty"`
+}
+
+// HostGroupPropagateOption defines a function signature for options to configure a HostGroupPropagateRequest.
+type HostGroupPropagateOption func(*HostGroupPropagateRequest)
+
+// NewHostGroupPropagateRequest creates a new HostGroupPropagateRequest for the host groups groupIDs
+// and applies any provided options. At least one of permissions and tag filters must be propagated.
+func NewHostGroupPropagateRequest(groupIDs []string, options ...HostGroupPropagateOption) *HostGroupPropagateRequest {
+	hpr := &HostGroupPropagateRequest{
+		JSONRPC: JSONRPC,
+		Method:  "hostgroup.propagate",
+		Params:  HostGroupPropagateParams{Groups: make([]HostGroupID, 0, len(groupIDs))},
+	}
+	for _, id := range groupIDs {
+		hpr.Params.Groups = append(hpr.Params.Groups, HostGroupID{GroupID: id})
+	}
+
+	for _, opt := range options {
+		opt(hpr)
+	}
+	return hpr
+}
+
+// WithHostGroupPropagatePermissions sets whether the permissions are propagated.
+func WithHostGroupPropagatePermissions(flag bool) HostGroupPropagateOption {
+	return func(hpr *HostGroupPropagateRequest) {
+		hpr.Params.Permissions = flag
+	}
+}
+
+// WithHostGroupPropagateTagFilters sets whether the tag filters are propagated.
+func WithHostGroupPropagateTagFilters(flag bool) HostGroupPropagateOption {
+	return func(hpr *HostGroupPropagateRequest) {
+		hpr.Params.TagFilters = flag
+	}
+}
+
+// WithHostGroupPropagateAuth sets the authentication token for the API request.
+func WithHostGroupPropagateAuth(token string) HostGroupPropagateOption {
+	return func(hpr *HostGroupPropagateRequest) {
+		hpr.Auth = token
+	}
+}
+
+// WithHostGroupPropagateID sets the ID for the API request.
+func WithHostGroupPropagateID(id int) HostGroupPropagateOption {
+	return func(hpr *HostGroupPropagateRequest) {
+		hpr.ID = id
+	}
+}
@@ -0,0 +1,37 @@
+package zabbix
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHostGroupPropagate tests propagating permissions to subgroups
+func TestHostGroupPropagate(t *testing.T) {
+	t.Parallel()
+
+	server := newHostGroupMassServer(t, "hostgroup.propagate", map[string]any{
+		"groups":      []any{map[string]any{"groupid": "11"}},
+		"permissions": true,
+		"tag_filters": false,
+	})
+	defer server.Close()
+
+	client := Client{
+		APIEndpoint: server.URL,
+		auth:        "test-auth-token",
+		client:      &http.Client{},
+	}
+
+	request := NewHostGroupPropagateRequest([]string{"11"},
+		WithHostGroupPropagatePermissions(true),
+		WithHostGroupPropagateAuth("test-auth-token"),
+		WithHostGroupPropagateID(1),
+	)
+	response, err := client.HostGroupPropagate(context.Background(), request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"11"}, response.Result.GroupIDs)
+}
@@ -0,0 +1,56 @@
+package zabbix
+
+// HostGroupUpdateRequest represents the JSON-RPC request for hostgroup.update.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/hostgroup/update
+type HostGroupUpdateRequest struct {
+	JSONRPC string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  []HostGroup `json:"params"` // Host groups to update. 'groupid' is required, only 'name' can be changed.
+	Auth    string      `json:"auth,omitempty"`
+	ID      int         `json:"id"`
+}
+
+// HostGroupUpdateResponseData contains the 'groupids' from the hostgroup.update response.
+type HostGroupUpdateResponseData struct {
+	GroupIDs []string `json:"groupids"`
+}
+
+// HostGroupUpdateResponse represents the JSON-RPC response for hostgroup.update.
+type HostGroupUpdateResponse struct {
+	JSONRPC string                      `json:"jsonrpc"`
+	Result  HostGroupUpdateResponseData `json:"result"`
+	ID      int                         `json:"id"`
+	Error   *Error                      `json:"error,omitempty"`
+}
+
+// HostGroupUpdateOption defines a function signature for options to configure a HostGroupUpdateRequest.
+type HostGroupUpdateOption func(*HostGroupUpdateRequest)
+
+// NewHostGroupUpdateRequest creates a new HostGroupUpdateRequest renaming the host group groupID to name,
+// and applies any provided options.
+func NewHostGroupUpdateRequest(groupID, name string, options ...HostGroupUpdateOption) *HostGroupUpdateRequest {
+	hur := &HostGroupUpdateRequest{
+		JSONRPC: JSONRPC,
+		Method:  "hostgroup.update",
+		Params:  []HostGroup{{GroupID: groupID, Name: name}},
+	}
+
+	for _, opt := range options {
+		opt(hur)
+	}
+	return hur
+}
+
+// WithHostGroupUpdateAuth sets the authentication token for the API request.
+func WithHostGroupUpdateAuth(token string) HostGroupUpdateOption {
+	return func(hur *HostGroupUpdateRequest) {
+		hur.Auth = token
+	}
+}
+
+// WithHostGroupUpdateID sets the ID for the API request.
+func WithHostGroupUpdateID(id int) HostGroupUpdateOption {
+	return func(hur *HostGroupUpdateRequest) {
+		hur.ID = id
+	}
+}
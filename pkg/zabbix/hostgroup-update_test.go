@@ -0,0 +1,90 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHostGroupUpdateRename tests renaming a host group
+func TestHostGroupUpdateRename(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var raw map[string]any
+		err := json.NewDecoder(r.Body).Decode(&raw)
+		assert.NoError(t, err)
+
+		// Only the ID and the new name are sent
+		assert.Equal(t, "hostgroup.update", raw["method"])
+		assert.Equal(t, "test-auth-token", raw["auth"])
+		assert.Equal(t, []any{map[string]any{"groupid": "100", "name": "Prod/EU/Web"}}, raw["params"])
+
+		response := HostGroupUpdateResponse{
+			JSONRPC: JSONRPC,
+			Result:  HostGroupUpdateResponseData{GroupIDs: []string{"100"}},
+			ID:      1,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := Client{
+		APIEndpoint: server.URL,
+		auth:        "test-auth-token",
+		client:      &http.Client{},
+	}
+
+	request := NewHostGroupUpdateRequest("100", "Prod/EU/Web",
+		WithHostGroupUpdateAuth("test-auth-token"),
+		WithHostGroupUpdateID(1),
+	)
+
+	response, err := client.HostGroupUpdate(context.Background(), request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"100"}, response.Result.GroupIDs)
+}
+
+// TestHostGroupUpdateError tests error handling when the new name is taken
+func TestHostGroupUpdateError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := HostGroupUpdateResponse{
+			JSONRPC: JSONRPC,
+			Error: &Error{
+				Code:    -32602,
+				Message: "Invalid params.",
+				Data:    "Host group \"Linux servers\" already exists.",
+			},
+			ID: 1,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := Client{
+		APIEndpoint: server.URL,
+		auth:        "test-auth-token",
+		client:      &http.Client{},
+	}
+
+	response, err := client.HostGroupUpdate(context.Background(), NewHostGroupUpdateRequest("100", "Linux servers"))
+
+	assert.Error(t, err)
+	assert.Nil(t, response)
+	zbxErr, ok := err.(*Error)
+	assert.True(t, ok, "error should be of type *zabbix.Error")
+	assert.Contains(t, zbxErr.Data, "already exists")
+}
@@ -1,5 +1,19 @@
 package zabbix
 
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Host group flags.
+const (
+	// HostGroupFlagPlain is the flag of a host group created manually.
+	HostGroupFlagPlain = "0"
+	// HostGroupFlagDiscovered is the flag of a host group created by host prototypes.
+	HostGroupFlagDiscovered = "4"
+)
+
 // HostGroup represents the Zabbix host group API object.
 // See: https://www.zabbix.com/documentation/current/en/manual/api/reference/hostgroup/object#host-group
 type HostGroup struct {
@@ -8,4 +22,49 @@ type HostGroup struct {
 	Flags    string `json:"flags,omitempty"`    // Readonly. Origin of the host group: 0 - a plain host group; 4 - a discovered host group.
 	Internal string `json:"internal,omitempty"` // Readonly. Whether the group is used internally by the Zabbix server. An internal group cannot be deleted. 0 - (default) not internal; 1 - internal.
 	UUID     string `json:"uuid,omitempty"`     // Universal unique identifier, used for linking imported host groups to already existing ones.
+	Hosts    []Host `json:"hosts,omitempty"`    // Readonly. Hosts of the group, populated by selectHosts.
+
+	// HostCount is the number of hosts of the group, populated by selectHosts, including
+	// selectHosts "count" which returns the number of hosts instead of the hosts.
+	HostCount int `json:"-"`
+}
+
+// IsDiscovered returns true if the host group was created by host prototypes.
+func (g *HostGroup) IsDiscovered() bool {
+	return g.Flags == HostGroupFlagDiscovered
+}
+
+// UnmarshalJSON is a custom unmarshaler for HostGroup to handle 'hosts' returned either as
+// an array of hosts or, with selectHosts "count", as a number of hosts.
+func (g *HostGroup) UnmarshalJSON(data []byte) error {
+	type alias HostGroup
+	aux := struct {
+		*alias
+		Hosts json.RawMessage `json:"hosts"`
+	}{alias: (*alias)(g)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return fmt.Errorf("cannot unmarshal host group: %w", err)
+	}
+
+	g.Hosts, g.HostCount = nil, 0
+	if len(aux.Hosts) == 0 || string(aux.Hosts) == "null" {
+		return nil
+	}
+	if aux.Hosts[0] == '"' {
+		var count string
+		if err := json.Unmarshal(aux.Hosts, &count); err != nil {
+			return fmt.Errorf("cannot unmarshal host count: %w", err)
+		}
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return fmt.Errorf("invalid host count %q: %w", count, err)
+		}
+		g.HostCount = n
+		return nil
+	}
+	if err := json.Unmarshal(aux.Hosts, &g.Hosts); err != nil {
+		return fmt.Errorf("cannot unmarshal hosts: %w", err)
+	}
+	g.HostCount = len(g.Hosts)
+	return nil
 }
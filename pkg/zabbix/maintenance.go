@@ -17,6 +17,7 @@ const (
 	MethodMaintenanceCreate = "maintenance.create"
 	MethodMaintenanceGet    = "maintenance.get"
 	MethodMaintenanceDelete = "maintenance.delete"
+	MethodMaintenanceUpdate = "maintenance.update"
 )
 
 // StringInt64 is a custom type that can unmarshal both string and integer JSON values into an int64
@@ -247,6 +248,10 @@ type Maintenance struct {
 	Tags []ProblemTag `json:"tags,omitempty"`
 	// Type of tag evaluation (0=AND, 1=OR)
 	TagsEvalType TagsEvalType `json:"tags_evaltype,omitempty"`
+	// Host groups of the maintenance (readonly, populated by selectGroups)
+	Groups []HostGroup `json:"groups,omitempty"`
+	// Hosts of the maintenance (readonly, populated by selectHosts)
+	Hosts []Host `json:"hosts,omitempty"`
 }
 
 // TimePeriod represents a time period for maintenance.
@@ -273,14 +278,68 @@ type TimePeriod struct {
 	Period int `json:"period,omitempty"`
 }
 
+// UnmarshalJSON is a custom unmarshaler for TimePeriod to handle the numeric fields returned as strings
+func (tp *TimePeriod) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		TimePeriodID   string         `json:"timeperiodid"`
+		TimePeriodType TimePeriodType `json:"timeperiod_type"`
+		StartDate      StringInt64    `json:"start_date"`
+		Every          StringInt64    `json:"every"`
+		Day            StringInt64    `json:"day"`
+		DayOfWeek      StringInt64    `json:"dayofweek"`
+		Month          StringInt64    `json:"month"`
+		Year           StringInt64    `json:"year"`
+		StartTime      StringInt64    `json:"start_time"`
+		Period         StringInt64    `json:"period"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal TimePeriod: %w", err)
+	}
+	*tp = TimePeriod{
+		TimePeriodID:   raw.TimePeriodID,
+		TimePeriodType: raw.TimePeriodType,
+		StartDate:      int64(raw.StartDate),
+		Every:          int(raw.Every),
+		Day:            int(raw.Day),
+		DayOfWeek:      int(raw.DayOfWeek),
+		Month:          int(raw.Month),
+		Year:           int(raw.Year),
+		StartTime:      int(raw.StartTime),
+		Period:         int(raw.Period),
+	}
+	return nil
+}
+
+// Operators of the problem tags of a maintenance.
+const (
+	// MaintenanceTagOperatorEquals - the tag value must be equal
+	MaintenanceTagOperatorEquals = 0
+	// MaintenanceTagOperatorContains - the tag value must contain the value (server default)
+	MaintenanceTagOperatorContains = 2
+)
+
 // ProblemTag represents a problem tag for maintenance.
 type ProblemTag struct {
 	// Tag name
 	Tag string `json:"tag"`
 	// Tag value
 	Value string `json:"value,omitempty"`
-	// Tag operator
-	Operator int `json:"operator,omitempty"`
+	// Tag operator, always sent as 0 means equals
+	Operator int `json:"operator"`
+}
+
+// UnmarshalJSON is a custom unmarshaler for ProblemTag to handle an operator returned as a string
+func (pt *ProblemTag) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Tag      string      `json:"tag"`
+		Value    string      `json:"value"`
+		Operator StringInt64 `json:"operator"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal ProblemTag: %w", err)
+	}
+	*pt = ProblemTag{Tag: raw.Tag, Value: raw.Value, Operator: int(raw.Operator)}
+	return nil
 }
 
 // MaintenanceResponse represents the response from maintenance API calls.
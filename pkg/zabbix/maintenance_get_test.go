@@ -444,3 +444,52 @@ func TestMaintenanceGetWithCommonGetParams(t *testing.T) {
 		t.Fatalf("Expected no error, got %v", err)
 	}
 }
+
+func TestMaintenanceGetStringValues(t *testing.T) {
+	t.Parallel()
+
+	// Shaped like a real maintenance.get response with selectTimeperiods and selectTags set to
+	// "extend": every number is returned as a string.
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"jsonrpc":"2.0","result":[{
+			"maintenanceid":"3","name":"Weekly patching","maintenance_type":"0","description":"",
+			"active_since":"1760911200","active_till":"1792447200","tags_evaltype":"2",
+			"groups":[{"groupid":"2","name":"Linux servers"}],
+			"hosts":[{"hostid":"10084","host":"web01","name":"web01"}],
+			"timeperiods":[
+				{"timeperiod_type":"2","every":"1","month":"0","dayofweek":"1","day":"0",
+				 "start_time":"7200","period":"5400","start_date":"1760911200"},
+				{"timeperiod_type":"0","every":"1","month":"0","dayofweek":"0","day":"1",
+				 "start_time":"0","period":"3600","start_date":"1761000000"}
+			],
+			"tags":[{"tag":"service","operator":"0","value":"web"},{"tag":"env","operator":"2","value":""}]
+		}],"id":1}`))
+	}))
+	defer ts.Close()
+
+	client := &Client{APIEndpoint: ts.URL, client: &http.Client{}}
+	response, err := client.MaintenanceGet(context.Background(), NewMaintenanceGetRequest(WithMaintenanceGetSelectTimePeriods("extend")))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(response.Result) != 1 {
+		t.Fatalf("Expected 1 maintenance, got %d", len(response.Result))
+	}
+
+	m := response.Result[0]
+	weekly := TimePeriod{TimePeriodType: TimePeriodTypeWeekly, StartDate: 1760911200, Every: 1, DayOfWeek: 1, StartTime: 7200, Period: 5400}
+	if m.TimePeriods[0] != weekly {
+		t.Errorf("Expected time period %+v, got %+v", weekly, m.TimePeriods[0])
+	}
+	oneTime := TimePeriod{TimePeriodType: TimePeriodTypeOneTime, StartDate: 1761000000, Every: 1, Day: 1, Period: 3600}
+	if m.TimePeriods[1] != oneTime {
+		t.Errorf("Expected time period %+v, got %+v", oneTime, m.TimePeriods[1])
+	}
+	if m.Tags[0] != (ProblemTag{Tag: "service", Value: "web", Operator: 0}) || m.Tags[1] != (ProblemTag{Tag: "env", Operator: 2}) {
+		t.Errorf("Unexpected tags %+v", m.Tags)
+	}
+	if m.TagsEvalType != 2 {
+		t.Errorf("Expected tags_evaltype 2, got %d", m.TagsEvalType)
+	}
+}
@@ -0,0 +1,52 @@
+package zabbix
+
+import (
+	"sort"
+	"time"
+)
+
+// Occurrences returns the intervals during which the maintenance is in effect and that overlap
+// [from, to), in chronological order. The occurrences of every time period are computed in the
+// location of from (Zabbix evaluates them in the time zone of the server) and clipped to the
+// active window of the maintenance [active_since, active_till).
+func (m *Maintenance) Occurrences(from, to time.Time) []Occurrence {
+	activeSince := time.Unix(m.ActiveSince.Int64(), 0).In(from.Location())
+	activeTill := time.Unix(m.ActiveTill.Int64(), 0).In(from.Location())
+	if activeSince.After(from) {
+		from = activeSince
+	}
+	if activeTill.Before(to) {
+		to = activeTill
+	}
+	if !from.Before(to) {
+		return nil
+	}
+
+	var occurrences []Occurrence
+	for i := range m.TimePeriods {
+		for _, o := range m.TimePeriods[i].Occurrences(activeSince, from, to) {
+			if o.Start.Before(activeSince) {
+				o.Start = activeSince
+			}
+			if o.End.After(activeTill) {
+				o.End = activeTill
+			}
+			if o.Start.Before(o.End) {
+				occurrences = append(occurrences, o)
+			}
+		}
+	}
+	sort.Slice(occurrences, func(i, j int) bool {
+		return occurrences[i].Start.Before(occurrences[j].Start)
+	})
+	return occurrences
+}
+
+// ActiveAt returns the occurrence of the maintenance in effect at t, if any.
+func (m *Maintenance) ActiveAt(t time.Time) (Occurrence, bool) {
+	occurrences := m.Occurrences(t, t.Add(time.Second))
+	if len(occurrences) == 0 {
+		return Occurrence{}, false
+	}
+	return occurrences[0], true
+}
@@ -0,0 +1,55 @@
+package zabbix_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceOccurrences(t *testing.T) {
+	t.Parallel()
+
+	loc := time.UTC
+	activeSince := time.Date(2026, 1, 7, 1, 30, 0, 0, loc)
+	activeTill := time.Date(2026, 1, 10, 2, 30, 0, 0, loc)
+	m := zabbix.Maintenance{
+		ActiveSince: zabbix.StringInt64(activeSince.Unix()),
+		ActiveTill:  zabbix.StringInt64(activeTill.Unix()),
+		TimePeriods: []zabbix.TimePeriod{
+			{TimePeriodType: zabbix.TimePeriodTypeDaily, Every: 1, StartTime: 3600, Period: 2 * 3600},
+			{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: time.Date(2026, 1, 8, 12, 0, 0, 0, loc).Unix(), Period: 600},
+			// Outside of the active window.
+			{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: time.Date(2026, 2, 1, 0, 0, 0, 0, loc).Unix(), Period: 600},
+		},
+	}
+
+	intervals := func(occ []zabbix.Occurrence) []string {
+		out := make([]string, 0, len(occ))
+		for _, o := range occ {
+			out = append(out, o.Start.Format("01-02 15:04")+"/"+o.End.Format("01-02 15:04"))
+		}
+		return out
+	}
+
+	occ := m.Occurrences(time.Date(2026, 1, 1, 0, 0, 0, 0, loc), time.Date(2026, 3, 1, 0, 0, 0, 0, loc))
+	require.Equal(t, []string{
+		"01-07 01:30/01-07 03:00", // clipped to active_since
+		"01-08 01:00/01-08 03:00",
+		"01-08 12:00/01-08 12:10",
+		"01-09 01:00/01-09 03:00",
+		"01-10 01:00/01-10 02:30", // clipped to active_till
+	}, intervals(occ))
+
+	occ = m.Occurrences(time.Date(2026, 1, 8, 2, 0, 0, 0, loc), time.Date(2026, 1, 8, 13, 0, 0, 0, loc))
+	require.Equal(t, []string{"01-08 01:00/01-08 03:00", "01-08 12:00/01-08 12:10"}, intervals(occ))
+
+	require.Empty(t, m.Occurrences(activeTill, activeTill.Add(24*time.Hour)))
+
+	o, ok := m.ActiveAt(time.Date(2026, 1, 9, 2, 59, 0, 0, loc))
+	require.True(t, ok)
+	require.Equal(t, time.Date(2026, 1, 9, 1, 0, 0, 0, loc), o.Start)
+	_, ok = m.ActiveAt(time.Date(2026, 1, 9, 3, 0, 0, 0, loc))
+	require.False(t, ok)
+}
@@ -0,0 +1,207 @@
+package zabbix
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidTimePeriod is returned when a maintenance time period is out of the documented ranges.
+var ErrInvalidTimePeriod = errors.New("invalid time period")
+
+// Ranges of the TimePeriod fields.
+const (
+	secondsPerDay  = 24 * 60 * 60
+	daysPerWeek    = 7
+	maxDayOfWeek   = 6
+	maxDayOfMonth  = 31
+	maxMonth       = 12
+	maxWeekOfMonth = 5 // 5 means the last week of the month
+	hoursPerDay    = 24
+	minutesPerHour = 60
+	secondsPerHour = minutesPerHour * 60
+	secondsPerMin  = 60
+)
+
+// Occurrence is a time interval during which a maintenance time period is active.
+type Occurrence struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Validate checks that the fields required by the time period type are set and within
+// the ranges documented on TimePeriod.
+func (tp *TimePeriod) Validate() error {
+	if tp.Period <= 0 {
+		return fmt.Errorf("%w: period must be a positive number of seconds, got %d", ErrInvalidTimePeriod, tp.Period)
+	}
+	if tp.TimePeriodType != TimePeriodTypeOneTime && (tp.StartTime < 0 || tp.StartTime >= secondsPerDay) {
+		return fmt.Errorf("%w: start_time must be between 0 and %d seconds, got %d", ErrInvalidTimePeriod, secondsPerDay-1, tp.StartTime)
+	}
+	if tp.Every < 0 {
+		return fmt.Errorf("%w: every must be positive, got %d", ErrInvalidTimePeriod, tp.Every)
+	}
+
+	switch tp.TimePeriodType {
+	case TimePeriodTypeOneTime:
+		if tp.StartDate <= 0 {
+			return fmt.Errorf("%w: start_date is required for a one-time period", ErrInvalidTimePeriod)
+		}
+	case TimePeriodTypeDaily:
+	case TimePeriodTypeWeekly:
+		return validateDayOfWeek(tp.DayOfWeek)
+	case TimePeriodTypeMonthly:
+		return validateDay(tp.Day)
+	case TimePeriodTypeMonthlyByWeekday:
+		if tp.Every < 1 || tp.Every > maxWeekOfMonth {
+			return fmt.Errorf("%w: every (week of the month) must be between 1 and %d, got %d", ErrInvalidTimePeriod, maxWeekOfMonth, tp.Every)
+		}
+		return validateDayOfWeek(tp.DayOfWeek)
+	case TimePeriodTypeYearly:
+		if tp.Month < 1 || tp.Month > maxMonth {
+			return fmt.Errorf("%w: month must be between 1 and %d, got %d", ErrInvalidTimePeriod, maxMonth, tp.Month)
+		}
+		return validateDay(tp.Day)
+	default:
+		return fmt.Errorf("%w: unknown time period type %d", ErrInvalidTimePeriod, tp.TimePeriodType)
+	}
+	return nil
+}
+
+func validateDayOfWeek(dayOfWeek int) error {
+	if dayOfWeek < 0 || dayOfWeek > maxDayOfWeek {
+		return fmt.Errorf("%w: dayofweek must be between 0 (Sunday) and %d (Saturday), got %d", ErrInvalidTimePeriod, maxDayOfWeek, dayOfWeek)
+	}
+	return nil
+}
+
+func validateDay(day int) error {
+	if day < 1 || day > maxDayOfMonth {
+		return fmt.Errorf("%w: day must be between 1 and %d, got %d", ErrInvalidTimePeriod, maxDayOfMonth, day)
+	}
+	return nil
+}
+
+// every returns the frequency of a recurring period (Every defaults to 1).
+func (tp *TimePeriod) every() int {
+	if tp.Every < 1 {
+		return 1
+	}
+	return tp.Every
+}
+
+// Occurrences returns the intervals of the time period overlapping [from, to), in chronological order.
+// Recurring periods are anchored on activeSince (e.g. "every 2 weeks" counts weeks from the week of
+// activeSince) and evaluated in the location of activeSince. Intervals are not clipped to [from, to)
+// nor to the maintenance active window.
+func (tp *TimePeriod) Occurrences(activeSince, from, to time.Time) []Occurrence {
+	period := time.Duration(tp.Period) * time.Second
+	if tp.TimePeriodType == TimePeriodTypeOneTime {
+		start := time.Unix(tp.StartDate, 0).In(activeSince.Location())
+		end := start.Add(period)
+		if start.Before(to) && end.After(from) {
+			return []Occurrence{{Start: start, End: end}}
+		}
+		return nil
+	}
+
+	loc := activeSince.Location()
+	anchor := civilDay(activeSince.In(loc))
+	// Start early enough to catch an occurrence that began before from and is still running.
+	first := civilDay(from.In(loc).Add(-period)).AddDate(0, 0, -1)
+	if first.Before(anchor) {
+		first = anchor
+	}
+	last := civilDay(to.In(loc))
+
+	var occurrences []Occurrence
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if !tp.matchesDay(anchor, day) {
+			continue
+		}
+		start := time.Date(day.Year(), day.Month(), day.Day(),
+			tp.StartTime/secondsPerHour, tp.StartTime%secondsPerHour/secondsPerMin, tp.StartTime%secondsPerMin, 0, loc)
+		end := start.Add(period)
+		if start.Before(to) && end.After(from) {
+			occurrences = append(occurrences, Occurrence{Start: start, End: end})
+		}
+	}
+	return occurrences
+}
+
+// matchesDay returns true if a recurring period starts on day. Both anchor and day are civil days (UTC midnight).
+func (tp *TimePeriod) matchesDay(anchor, day time.Time) bool {
+	switch tp.TimePeriodType {
+	case TimePeriodTypeDaily:
+		return daysBetween(anchor, day)%tp.every() == 0
+	case TimePeriodTypeWeekly:
+		if int(day.Weekday()) != tp.DayOfWeek {
+			return false
+		}
+		// Weeks start on Monday.
+		anchorMonday := anchor.AddDate(0, 0, -((int(anchor.Weekday()) + daysPerWeek - 1) % daysPerWeek))
+		return (daysBetween(anchorMonday, day)/daysPerWeek)%tp.every() == 0
+	case TimePeriodTypeMonthly:
+		if day.Day() != tp.Day {
+			return false
+		}
+		months := (day.Year()-anchor.Year())*maxMonth + int(day.Month()) - int(anchor.Month())
+		return months%tp.every() == 0
+	case TimePeriodTypeMonthlyByWeekday:
+		if int(day.Weekday()) != tp.DayOfWeek {
+			return false
+		}
+		if tp.Every == maxWeekOfMonth {
+			// Last such weekday of the month.
+			return day.AddDate(0, 0, daysPerWeek).Month() != day.Month()
+		}
+		return (day.Day()-1)/daysPerWeek+1 == tp.Every
+	case TimePeriodTypeYearly:
+		if tp.Year > 0 && day.Year() != tp.Year {
+			return false
+		}
+		return int(day.Month()) == tp.Month && day.Day() == tp.Day
+	default:
+		return false
+	}
+}
+
+// civilDay returns the calendar day of t as a UTC midnight, so that days can be counted
+// without daylight saving time shifts.
+func civilDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween returns the number of days from a to b, both civil days.
+func daysBetween(a, b time.Time) int {
+	return int(b.Sub(a).Hours()) / hoursPerDay
+}
+
+// Normalized returns a copy of the time period keeping only the fields used by its type, with
+// Every set to 1 when omitted on a daily, weekly or monthly period. The server returns every
+// field of every period; normalized periods can be compared with the ones sent to it.
+func (tp *TimePeriod) Normalized() TimePeriod {
+	n := TimePeriod{TimePeriodType: tp.TimePeriodType, Period: tp.Period}
+	switch tp.TimePeriodType {
+	case TimePeriodTypeOneTime:
+		n.StartDate = tp.StartDate
+		return n
+	case TimePeriodTypeDaily:
+		n.Every = tp.every()
+	case TimePeriodTypeWeekly:
+		n.Every = tp.every()
+		n.DayOfWeek = tp.DayOfWeek
+	case TimePeriodTypeMonthly:
+		n.Every = tp.every()
+		n.Day = tp.Day
+	case TimePeriodTypeMonthlyByWeekday:
+		n.Every = tp.Every
+		n.DayOfWeek = tp.DayOfWeek
+	case TimePeriodTypeYearly:
+		n.Month = tp.Month
+		n.Day = tp.Day
+		n.Year = tp.Year
+	}
+	n.StartTime = tp.StartTime
+	return n
+}
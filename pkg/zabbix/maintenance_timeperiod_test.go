@@ -3,6 +3,7 @@ package zabbix_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
 	"github.com/stretchr/testify/require"
@@ -305,3 +306,131 @@ func TestTimePeriodJSONMarshaling(t *testing.T) {
 	require.Equal(t, tp.StartTime, unmarshaled.StartTime)
 	require.Equal(t, tp.Period, unmarshaled.Period)
 }
+
+func TestTimePeriodValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		tp      zabbix.TimePeriod
+		wantErr bool
+	}{
+		{"one-time", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: 1700000000, Period: 3600}, false},
+		{"one-time without start date", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeOneTime, Period: 3600}, true},
+		{"no period", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeDaily}, true},
+		{"daily", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeDaily, StartTime: 7200, Period: 3600}, false},
+		{"start time out of day", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeDaily, StartTime: 86400, Period: 3600}, true},
+		{"weekly", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeWeekly, DayOfWeek: 4, Period: 3600}, false},
+		{"weekly bad day", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeWeekly, DayOfWeek: 7, Period: 3600}, true},
+		{"monthly", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeMonthly, Day: 31, Period: 3600}, false},
+		{"monthly bad day", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeMonthly, Day: 32, Period: 3600}, true},
+		{"monthly by weekday", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeMonthlyByWeekday, Every: 5, DayOfWeek: 1, Period: 3600}, false},
+		{"monthly by weekday bad week", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeMonthlyByWeekday, Every: 6, DayOfWeek: 1, Period: 3600}, true},
+		{"yearly", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeYearly, Month: 12, Day: 25, Period: 3600}, false},
+		{"yearly bad month", zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeYearly, Month: 13, Day: 1, Period: 3600}, true},
+		{"unknown type", zabbix.TimePeriod{TimePeriodType: 9, Period: 3600}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.tp.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, zabbix.ErrInvalidTimePeriod)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTimePeriodOccurrences(t *testing.T) {
+	t.Parallel()
+
+	loc := time.UTC
+	// Wednesday 2026-01-07
+	activeSince := time.Date(2026, 1, 7, 0, 0, 0, 0, loc)
+	from := activeSince
+	to := time.Date(2026, 3, 1, 0, 0, 0, 0, loc)
+
+	starts := func(occ []zabbix.Occurrence) []string {
+		out := make([]string, 0, len(occ))
+		for _, o := range occ {
+			out = append(out, o.Start.Format("2006-01-02 15:04"))
+		}
+		return out
+	}
+
+	t.Run("one-time", func(t *testing.T) {
+		t.Parallel()
+		tp := zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: activeSince.Add(time.Hour).Unix(), Period: 1800}
+		occ := tp.Occurrences(activeSince, from, to)
+		require.Len(t, occ, 1)
+		require.Equal(t, 30*time.Minute, occ[0].End.Sub(occ[0].Start))
+		require.Empty(t, tp.Occurrences(activeSince, to, to.Add(time.Hour)))
+	})
+
+	t.Run("every 3 days", func(t *testing.T) {
+		t.Parallel()
+		tp := zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeDaily, Every: 3, StartTime: 2 * 3600, Period: 3600}
+		occ := tp.Occurrences(activeSince, from, time.Date(2026, 1, 14, 0, 0, 0, 0, loc))
+		require.Equal(t, []string{"2026-01-07 02:00", "2026-01-10 02:00", "2026-01-13 02:00"}, starts(occ))
+	})
+
+	t.Run("every other Monday", func(t *testing.T) {
+		t.Parallel()
+		tp := zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeWeekly, Every: 2, DayOfWeek: 1, StartTime: 22 * 3600, Period: 3600}
+		occ := tp.Occurrences(activeSince, from, time.Date(2026, 2, 1, 0, 0, 0, 0, loc))
+		// The week of activeSince starts on Monday 2026-01-05, so 01-19 is the next one.
+		require.Equal(t, []string{"2026-01-19 22:00"}, starts(occ))
+	})
+
+	t.Run("monthly on the 31st", func(t *testing.T) {
+		t.Parallel()
+		tp := zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeMonthly, Day: 31, Period: 3600}
+		occ := tp.Occurrences(activeSince, from, time.Date(2026, 6, 1, 0, 0, 0, 0, loc))
+		require.Equal(t, []string{"2026-01-31 00:00", "2026-03-31 00:00", "2026-05-31 00:00"}, starts(occ))
+	})
+
+	t.Run("second and last Friday", func(t *testing.T) {
+		t.Parallel()
+		second := zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeMonthlyByWeekday, Every: 2, DayOfWeek: 5, Period: 3600}
+		require.Equal(t, []string{"2026-01-09 00:00", "2026-02-13 00:00"}, starts(second.Occurrences(activeSince, from, to)))
+		last := zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeMonthlyByWeekday, Every: 5, DayOfWeek: 5, Period: 3600}
+		require.Equal(t, []string{"2026-01-30 00:00", "2026-02-27 00:00"}, starts(last.Occurrences(activeSince, from, to)))
+	})
+
+	t.Run("yearly", func(t *testing.T) {
+		t.Parallel()
+		tp := zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeYearly, Month: 2, Day: 14, StartTime: 3600, Period: 3600}
+		occ := tp.Occurrences(activeSince, from, time.Date(2028, 1, 1, 0, 0, 0, 0, loc))
+		require.Equal(t, []string{"2026-02-14 01:00", "2027-02-14 01:00"}, starts(occ))
+	})
+
+	t.Run("running occurrence", func(t *testing.T) {
+		t.Parallel()
+		tp := zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeDaily, StartTime: 23 * 3600, Period: 2 * 3600}
+		at := time.Date(2026, 1, 10, 0, 30, 0, 0, loc)
+		occ := tp.Occurrences(activeSince, at, at.Add(time.Minute))
+		require.Equal(t, []string{"2026-01-09 23:00"}, starts(occ))
+	})
+}
+
+func TestTimePeriodNormalized(t *testing.T) {
+	t.Parallel()
+
+	// As returned by the server: every field is set.
+	weekly := zabbix.TimePeriod{
+		TimePeriodID: "7", TimePeriodType: zabbix.TimePeriodTypeWeekly, StartDate: 1700000000,
+		Every: 1, Day: 1, DayOfWeek: 4, Month: 1, StartTime: 7200, Period: 3600,
+	}
+	require.Equal(t, zabbix.TimePeriod{
+		TimePeriodType: zabbix.TimePeriodTypeWeekly, Every: 1, DayOfWeek: 4, StartTime: 7200, Period: 3600,
+	}, weekly.Normalized())
+
+	oneTime := zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: 1700000000, Every: 1, StartTime: 60, Period: 3600}
+	require.Equal(t, zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: 1700000000, Period: 3600}, oneTime.Normalized())
+
+	daily := zabbix.TimePeriod{TimePeriodType: zabbix.TimePeriodTypeDaily, Period: 3600}
+	require.Equal(t, 1, daily.Normalized().Every)
+}
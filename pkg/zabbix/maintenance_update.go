@@ -0,0 +1,164 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MaintenanceUpdateResponse represents the response from maintenance.update API call.
+type MaintenanceUpdateResponse struct {
+	JSONRPC string              `json:"jsonrpc"`
+	Result  MaintenanceResponse `json:"result,omitempty"`
+	Error   *Error              `json:"error,omitempty"`
+	ID      int                 `json:"id"`
+}
+
+// MaintenanceUpdateOption defines a function signature for options to configure a MaintenanceUpdateRequest.
+type MaintenanceUpdateOption func(*MaintenanceUpdateRequest)
+
+// NewMaintenanceUpdateRequest creates a new MaintenanceUpdateRequest with default values and applies any provided options.
+// Default JSONRPC version is "2.0" and method is "maintenance.update".
+func NewMaintenanceUpdateRequest(options ...MaintenanceUpdateOption) *MaintenanceUpdateRequest {
+	mur := &MaintenanceUpdateRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodMaintenanceUpdate,
+		Params:  Maintenance{},
+	}
+	for _, opt := range options {
+		opt(mur)
+	}
+	return mur
+}
+
+// WithMaintenanceUpdateMaintenance sets the maintenance to update, as returned by UpdateParams.
+// The maintenance must have its MaintenanceID set. Time periods, groups, hosts and tags that are
+// set replace the existing ones; an empty, non-nil slice of group IDs, host IDs or tags clears them.
+func WithMaintenanceUpdateMaintenance(maintenance Maintenance) MaintenanceUpdateOption {
+	return func(mur *MaintenanceUpdateRequest) {
+		mur.Params = maintenance
+	}
+}
+
+// MarshalJSON sends the group IDs, host IDs and tags of the maintenance even when they are empty
+// but not nil, and the tags evaluation type whenever tags are sent, as omitempty would otherwise
+// drop them and leave the existing values in place.
+func (r MaintenanceUpdateRequest) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(r.Params)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal maintenance update params: %w", err)
+	}
+	var params map[string]json.RawMessage
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil, fmt.Errorf("cannot marshal maintenance update params: %w", err)
+	}
+	explicit := map[string]any{}
+	if r.Params.GroupIDs != nil {
+		explicit["groupids"] = r.Params.GroupIDs
+	}
+	if r.Params.HostIDs != nil {
+		explicit["hostids"] = r.Params.HostIDs
+	}
+	if r.Params.Tags != nil {
+		explicit["tags"] = r.Params.Tags
+		explicit["tags_evaltype"] = r.Params.TagsEvalType
+	}
+	for key, value := range explicit {
+		if params[key], err = json.Marshal(value); err != nil {
+			return nil, fmt.Errorf("cannot marshal maintenance update params: %w", err)
+		}
+	}
+
+	type alias MaintenanceUpdateRequest
+	data, err = json.Marshal(struct {
+		alias
+		Params map[string]json.RawMessage `json:"params"`
+	}{alias(r), params})
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal maintenance update request: %w", err)
+	}
+	return data, nil
+}
+
+// WithMaintenanceUpdateAuthToken sets the authentication token for the API request.
+func WithMaintenanceUpdateAuthToken(token string) MaintenanceUpdateOption {
+	return func(mur *MaintenanceUpdateRequest) {
+		mur.Auth = token
+	}
+}
+
+// WithMaintenanceUpdateRequestID sets the ID for the API request.
+func WithMaintenanceUpdateRequestID(id int) MaintenanceUpdateOption {
+	return func(mur *MaintenanceUpdateRequest) {
+		mur.ID = id
+	}
+}
+
+// UpdateParams returns a copy of a maintenance, as returned by maintenance.get with selectGroups,
+// selectHosts, selectTags and selectTimeperiods, that can be sent to maintenance.update without
+// losing any field: readonly fields are cleared and groups and hosts are converted to IDs.
+func (m *Maintenance) UpdateParams() Maintenance {
+	params := *m
+	params.CreatedAt = 0
+	params.UpdatedAt = 0
+
+	params.TimePeriods = make([]TimePeriod, len(m.TimePeriods))
+	for i, tp := range m.TimePeriods {
+		tp.TimePeriodID = ""
+		params.TimePeriods[i] = tp
+	}
+
+	if len(params.GroupIDs) == 0 {
+		for _, g := range m.Groups {
+			params.GroupIDs = append(params.GroupIDs, g.GroupID)
+		}
+	}
+	if len(params.HostIDs) == 0 {
+		for _, h := range m.Hosts {
+			params.HostIDs = append(params.HostIDs, h.HostID)
+		}
+	}
+	params.Groups = nil
+	params.Hosts = nil
+	return params
+}
+
+// Extend pushes the end of the maintenance (active_till) out by the given duration.
+// The one-time periods ending last are lengthened by the same duration so that the
+// maintenance stays in effect; recurring periods are left untouched.
+func (m *Maintenance) Extend(by time.Duration) {
+	m.ActiveTill = StringInt64(m.ActiveTill.Int64() + int64(by.Seconds()))
+
+	var lastEnd int64
+	for _, tp := range m.TimePeriods {
+		if tp.TimePeriodType == TimePeriodTypeOneTime && tp.StartDate+int64(tp.Period) > lastEnd {
+			lastEnd = tp.StartDate + int64(tp.Period)
+		}
+	}
+	for i, tp := range m.TimePeriods {
+		if tp.TimePeriodType == TimePeriodTypeOneTime && tp.StartDate+int64(tp.Period) == lastEnd {
+			m.TimePeriods[i].Period += int(by.Seconds())
+		}
+	}
+}
+
+// MaintenanceUpdate sends a maintenance.update request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) MaintenanceUpdate(ctx context.Context, request *MaintenanceUpdateRequest) (*MaintenanceUpdateResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for maintenance.update: %w", err)
+	}
+
+	var response MaintenanceUpdateResponse
+	if err := handleRawResponse(statusCode, respBody, MethodMaintenanceUpdate, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
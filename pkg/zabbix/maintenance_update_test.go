@@ -0,0 +1,116 @@
+package zabbix_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceUpdateParams(t *testing.T) {
+	t.Parallel()
+
+	m := zabbix.Maintenance{
+		MaintenanceID: "7",
+		Name:          "deploy",
+		ActiveSince:   1000,
+		ActiveTill:    8200,
+		CreatedAt:     900,
+		TimePeriods:   []zabbix.TimePeriod{{TimePeriodID: "3", TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: 1000, Period: 3600}},
+		Groups:        []zabbix.HostGroup{{GroupID: "2", Name: "Linux servers"}},
+		Hosts:         []zabbix.Host{{HostID: "10084", Host: "web01"}},
+		Tags:          []zabbix.ProblemTag{{Tag: "service", Value: "web"}},
+		TagsEvalType:  zabbix.TagsEvalTypeOr,
+	}
+
+	params := m.UpdateParams()
+	require.Equal(t, []string{"2"}, params.GroupIDs)
+	require.Equal(t, []string{"10084"}, params.HostIDs)
+	require.Empty(t, params.TimePeriods[0].TimePeriodID)
+	require.Equal(t, "3", m.TimePeriods[0].TimePeriodID, "the original maintenance must not be modified")
+
+	data, err := json.Marshal(params)
+	require.NoError(t, err)
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(data, &raw))
+	require.NotContains(t, raw, "groups")
+	require.NotContains(t, raw, "hosts")
+	require.NotContains(t, raw, "created_at")
+	require.Equal(t, "deploy", raw["name"])
+	require.Contains(t, raw, "tags")
+	require.InDelta(t, 1, raw["tags_evaltype"], 0)
+}
+
+func TestMaintenanceExtend(t *testing.T) {
+	t.Parallel()
+
+	m := zabbix.Maintenance{
+		ActiveSince: 1000,
+		ActiveTill:  8200,
+		TimePeriods: []zabbix.TimePeriod{
+			{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: 1000, Period: 600},
+			{TimePeriodType: zabbix.TimePeriodTypeOneTime, StartDate: 5000, Period: 3200},
+			{TimePeriodType: zabbix.TimePeriodTypeDaily, StartTime: 3600, Period: 3600},
+		},
+	}
+	m.Extend(time.Hour)
+
+	require.Equal(t, int64(11800), m.ActiveTill.Int64())
+	require.Equal(t, 600, m.TimePeriods[0].Period)
+	require.Equal(t, 6800, m.TimePeriods[1].Period)
+	require.Equal(t, 3600, m.TimePeriods[2].Period)
+}
+
+func TestMaintenanceUpdate(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req zabbix.MaintenanceUpdateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "maintenance.update", req.Method)
+		require.Equal(t, "7", req.Params.MaintenanceID)
+		require.Equal(t, "token", req.Auth)
+		fmt.Fprintln(w, `{"jsonrpc":"2.0","result":{"maintenanceids":["7"]},"id":1}`)
+	}))
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.MaintenanceUpdate(context.Background(), zabbix.NewMaintenanceUpdateRequest(
+		zabbix.WithMaintenanceUpdateMaintenance(zabbix.Maintenance{MaintenanceID: "7", Name: "deploy"}),
+		zabbix.WithMaintenanceUpdateAuthToken("token"),
+		zabbix.WithMaintenanceUpdateRequestID(1),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []string{"7"}, resp.Result.MaintenanceIDs)
+}
+
+func TestMaintenanceUpdateRequestClearsEmptyFields(t *testing.T) {
+	t.Parallel()
+
+	request := zabbix.NewMaintenanceUpdateRequest(zabbix.WithMaintenanceUpdateMaintenance(zabbix.Maintenance{
+		MaintenanceID: "7",
+		HostIDs:       []string{"10084"},
+		GroupIDs:      []string{},
+		Tags:          []zabbix.ProblemTag{},
+	}))
+	data, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	var raw struct {
+		Method string         `json:"method"`
+		Params map[string]any `json:"params"`
+	}
+	require.NoError(t, json.Unmarshal(data, &raw))
+	require.Equal(t, "maintenance.update", raw.Method)
+	require.Equal(t, []any{"10084"}, raw.Params["hostids"])
+	require.Equal(t, []any{}, raw.Params["groupids"])
+	require.Equal(t, []any{}, raw.Params["tags"])
+	require.InDelta(t, 0, raw.Params["tags_evaltype"], 0)
+	require.NotContains(t, raw.Params, "timeperiods")
+}
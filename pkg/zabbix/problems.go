@@ -201,6 +201,13 @@ func GetProblemOptionExcludeSearch(excludeSearch bool) GetProblemOption {
 	}
 }
 
+// GetProblemOptionOutput sets the properties to return.
+func GetProblemOptionOutput(output any) GetProblemOption {
+	return func(g *GetProblemRequest) {
+		g.Params.Output = output
+	}
+}
+
 // GetProblemOptionLimit sets the limit as a filter option.
 func GetProblemOptionLimit(limit int) GetProblemOption {
 	return func(g *GetProblemRequest) {
@@ -237,6 +244,34 @@ func GetProblemOptionSelectHosts(selectQuery string) GetProblemOption {
 	}
 }
 
+// GetProblemOptionSelectAcknowledges sets the selectAcknowledges parameter for the problem.get request.
+func GetProblemOptionSelectAcknowledges(selectQuery string) GetProblemOption {
+	return func(g *GetProblemRequest) {
+		g.Params.SelectAcknowledges = selectQuery
+	}
+}
+
+// GetProblemOptionSelectTags sets the selectTags parameter for the problem.get request.
+func GetProblemOptionSelectTags(selectQuery string) GetProblemOption {
+	return func(g *GetProblemRequest) {
+		g.Params.SelectTags = selectQuery
+	}
+}
+
+// GetProblemOptionSortField sets the fields to sort the results by.
+func GetProblemOptionSortField(sortField []string) GetProblemOption {
+	return func(g *GetProblemRequest) {
+		g.Params.SortField = sortField
+	}
+}
+
+// GetProblemOptionSortOrder sets the sort order of the results.
+func GetProblemOptionSortOrder(sortOrder []string) GetProblemOption {
+	return func(g *GetProblemRequest) {
+		g.Params.SortOrder = sortOrder
+	}
+}
+
 // ProblemResponseTag represents a tag associated with a problem, as returned by problem.get with selectTags.
 // This is distinct from FilterProblemTags (used for filtering in params) and the ProblemTag in maintenance.go.
 // API Reference: problem.get, selectTags parameter.
@@ -263,9 +298,9 @@ type AcknowledgeEntry struct {
 	EventID       string      `json:"eventid"`
 	Clock         StringInt64 `json:"clock"`        // Unix timestamp
 	Message       string      `json:"message"`
-	Action        int         `json:"action"`       // integer
-	OldSeverity   int         `json:"old_severity"` // integer
-	NewSeverity   int         `json:"new_severity"` // integer
+	Action        StringInt64 `json:"action"`       // integer, returned as a string
+	OldSeverity   StringInt64 `json:"old_severity"` // integer, returned as a string
+	NewSeverity   StringInt64 `json:"new_severity"` // integer, returned as a string
 }
 
 // SuppressionDataEntry represents data about problem suppression.
@@ -274,8 +309,8 @@ type AcknowledgeEntry struct {
 // maintenanceid - (string) ID of the maintenance;
 // suppress_until - (integer) time until the problem is suppressed.
 type SuppressionDataEntry struct {
-	MaintenanceID string `json:"maintenanceid"`
-	SuppressUntil int64  `json:"suppress_until"` // timestamp
+	MaintenanceID string      `json:"maintenanceid"`
+	SuppressUntil StringInt64 `json:"suppress_until"` // timestamp, returned as a string
 }
 
 // HostInfo represents basic information about a host related to a problem.
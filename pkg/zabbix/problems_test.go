@@ -1,6 +1,7 @@
 package zabbix_test
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 	"time"
@@ -307,3 +308,32 @@ func TestProblem_GetSeverity(t *testing.T) {
 		t.Errorf("expected Unknown for invalid severity")
 	}
 }
+
+func TestProblem_UnmarshalAcknowledges(t *testing.T) {
+	data := `{
+		"eventid": "9",
+		"severity": "4",
+		"acknowledges": [{
+			"acknowledgeid": "1", "userid": "1", "eventid": "9", "clock": "1760000000",
+			"message": "Raised", "action": "10", "old_severity": "2", "new_severity": "4"
+		}],
+		"suppression_data": [{"maintenanceid": "3", "suppress_until": "1760003600"}]
+	}`
+	var p zabbix.Problem
+	if err := json.Unmarshal([]byte(data), &p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(p.Acknowledges) != 1 {
+		t.Fatalf("expected 1 acknowledge, got %d", len(p.Acknowledges))
+	}
+	ack := p.Acknowledges[0]
+	if ack.Action.Int64() != 10 || ack.OldSeverity.Int64() != 2 || ack.NewSeverity.Int64() != 4 {
+		t.Errorf("unexpected action %d, old severity %d, new severity %d", ack.Action, ack.OldSeverity, ack.NewSeverity)
+	}
+	if ack.Clock.Int64() != 1760000000 {
+		t.Errorf("expected clock 1760000000, got %d", ack.Clock)
+	}
+	if len(p.SuppressionData) != 1 || p.SuppressionData[0].SuppressUntil.Int64() != 1760003600 {
+		t.Errorf("unexpected suppression data %v", p.SuppressionData)
+	}
+}
@@ -1,5 +1,15 @@
 package zabbix
 
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidSeverity is returned when a severity cannot be parsed.
+var ErrInvalidSeverity = errors.New("invalid severity")
+
 // Severity represents the severity of an event.
 type Severity int
 
@@ -84,3 +94,22 @@ func GetSeverityString(severity string) Severity {
 		return NotClassified
 	}
 }
+
+// ParseSeverity parses a severity name (case-insensitive, e.g. "high" or "Not classified")
+// or its numeric value ("0" to "5").
+// Unlike GetSeverityString, it returns an error for unknown values.
+func ParseSeverity(severity string) (Severity, error) {
+	value := strings.TrimSpace(severity)
+	if n, err := strconv.Atoi(value); err == nil {
+		if n < int(NotClassified) || n > int(Disaster) {
+			return NotClassified, fmt.Errorf("%w: %s", ErrInvalidSeverity, severity)
+		}
+		return Severity(n), nil
+	}
+	for s := NotClassified; s <= Disaster; s++ {
+		if strings.EqualFold(s.String(), value) {
+			return s, nil
+		}
+	}
+	return NotClassified, fmt.Errorf("%w: %s", ErrInvalidSeverity, severity)
+}
@@ -70,3 +70,30 @@ func TestNewSeverity(t *testing.T) {
 		t.Errorf("NewSeverity(3) = %v, want %v", got, zabbix.Average)
 	}
 }
+
+func TestParseSeverity(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected zabbix.Severity
+		wantErr  bool
+	}{
+		{"Not classified", zabbix.NotClassified, false},
+		{"high", zabbix.High, false},
+		{" DISASTER ", zabbix.Disaster, false},
+		{"2", zabbix.Warning, false},
+		{"6", zabbix.NotClassified, true},
+		{"-1", zabbix.NotClassified, true},
+		{"foobar", zabbix.NotClassified, true},
+		{"", zabbix.NotClassified, true},
+	}
+	for _, c := range cases {
+		got, err := zabbix.ParseSeverity(c.input)
+		if (err != nil) != c.wantErr {
+			t.Errorf("ParseSeverity(%q) error = %v, wantErr %v", c.input, err, c.wantErr)
+			continue
+		}
+		if got != c.expected {
+			t.Errorf("ParseSeverity(%q) = %v, want %v", c.input, got, c.expected)
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package zabbix
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // TemplateGetParams defines the parameters for the Zabbix template.get API call.
 // See: https://www.zabbix.com/documentation/current/en/manual/api/reference/template/get
 type TemplateGetParams struct {
@@ -54,6 +59,75 @@ type Template struct {
 		Name    string `json:"name,omitempty"`    // Vendor name.
 		Version string `json:"version,omitempty"` // Vendor version.
 	} `json:"vendor,omitempty"` // Template vendor information.
+	ParentTemplates []Template      `json:"parentTemplates,omitempty"` // Linked templates, if selectParentTemplates is set.
+	Macros          []UserMacro     `json:"macros,omitempty"`          // Template macros, if selectMacros is set.
+	TemplateGroups  []TemplateGroup `json:"templategroups,omitempty"`  // Template groups, if selectTemplateGroups is set.
+	Tags            []HostTag       `json:"tags,omitempty"`            // Template tags, if selectTags is set.
+	Hosts           []Host          `json:"hosts,omitempty"`           // Hosts linked to the template, if selectHosts is set.
+
+	// HostCount, ItemCount, TriggerCount, GraphCount, DiscoveryCount and HTTPTestCount are the
+	// numbers of linked hosts, items, triggers, graphs, discovery rules and web scenarios of the
+	// template, populated by the matching select query, including "count".
+	HostCount      int `json:"-"`
+	ItemCount      int `json:"-"`
+	TriggerCount   int `json:"-"`
+	GraphCount     int `json:"-"`
+	DiscoveryCount int `json:"-"`
+	HTTPTestCount  int `json:"-"`
+}
+
+// UnmarshalJSON is a custom unmarshaler for Template to handle 'hosts', 'items', 'triggers',
+// 'graphs', 'discoveries' and 'httpTests' returned either as arrays or, with a "count" select
+// query, as numbers of objects.
+func (t *Template) UnmarshalJSON(data []byte) error {
+	type alias Template
+	aux := struct {
+		*alias
+		Hosts       json.RawMessage `json:"hosts"`
+		Items       json.RawMessage `json:"items"`
+		Triggers    json.RawMessage `json:"triggers"`
+		Graphs      json.RawMessage `json:"graphs"`
+		Discoveries json.RawMessage `json:"discoveries"`
+		HTTPTests   json.RawMessage `json:"httpTests"`
+	}{alias: (*alias)(t)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return fmt.Errorf("cannot unmarshal template: %w", err)
+	}
+
+	t.Hosts = nil
+	if len(aux.Hosts) > 0 && aux.Hosts[0] == '[' {
+		if err := json.Unmarshal(aux.Hosts, &t.Hosts); err != nil {
+			return fmt.Errorf("cannot unmarshal template hosts: %w", err)
+		}
+	}
+	counts := []struct {
+		name  string
+		data  json.RawMessage
+		count *int
+	}{
+		{"hosts", aux.Hosts, &t.HostCount},
+		{"items", aux.Items, &t.ItemCount},
+		{"triggers", aux.Triggers, &t.TriggerCount},
+		{"graphs", aux.Graphs, &t.GraphCount},
+		{"discoveries", aux.Discoveries, &t.DiscoveryCount},
+		{"web scenarios", aux.HTTPTests, &t.HTTPTestCount},
+	}
+	for _, c := range counts {
+		n, err := unmarshalObjectCount(c.data)
+		if err != nil {
+			return fmt.Errorf("cannot unmarshal template %s: %w", c.name, err)
+		}
+		*c.count = n
+	}
+	return nil
+}
+
+// DisplayName returns the visible name of the template, or its technical name if it has none.
+func (t *Template) DisplayName() string {
+	if t.Name != "" {
+		return t.Name
+	}
+	return t.Host
 }
 
 // TemplateGetResponse defines the JSON-RPC response structure for template.get.
@@ -330,3 +330,35 @@ func TestTemplateVendorStructUnmarshaling(t *testing.T) {
 	require.Equal(t, "Acme Corp", response.Result[0].Vendor.Name)
 	require.Equal(t, "2.5.1", response.Result[0].Vendor.Version)
 }
+
+func TestTemplateGetCounts(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"jsonrpc":"2.0","result":[
+			{"templateid":"10001","host":"Linux","hosts":"12","items":"42","triggers":"7","graphs":"3","discoveries":"2","httpTests":"0"},
+			{"templateid":"10002","host":"Nginx","hosts":[{"hostid":"10084","host":"web01"}],
+			 "templategroups":[{"groupid":"1","name":"Templates/Applications"}],
+			 "macros":[{"macro":"{$NGINX.PORT}","value":"80"}]}
+		],"id":1}`)
+	}))
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.TemplateGet(context.Background(), zabbix.NewTemplateGetRequest(
+		zabbix.WithTemplateGetSelectHosts("count"),
+		zabbix.WithTemplateGetSelectItems("count"),
+	))
+	require.NoError(t, err)
+	require.Len(t, resp.Result, 2)
+	linux := resp.Result[0]
+	require.Equal(t, []int{12, 42, 7, 3, 2, 0},
+		[]int{linux.HostCount, linux.ItemCount, linux.TriggerCount, linux.GraphCount, linux.DiscoveryCount, linux.HTTPTestCount})
+	require.Empty(t, linux.Hosts)
+	nginx := resp.Result[1]
+	require.Equal(t, 1, nginx.HostCount)
+	require.Equal(t, "web01", nginx.Hosts[0].Host)
+	require.Equal(t, "Templates/Applications", nginx.TemplateGroups[0].Name)
+	require.Equal(t, "80", nginx.Macros[0].Value)
+}
@@ -0,0 +1,45 @@
+package zabbix_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateMassAdd(t *testing.T) {
+	t.Parallel()
+
+	ts := newHostMassServer(t, zabbix.MethodTemplateMassAdd, map[string]any{
+		"templates":      []any{map[string]any{"templateid": "10050"}},
+		"templates_link": []any{map[string]any{"templateid": "10001"}},
+	}, `{"templateids":["10050"]}`)
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.TemplateMassAdd(context.Background(), zabbix.NewTemplateMassAddRequest(
+		[]string{"10050"},
+		zabbix.WithTemplateMassAddTemplatesLink([]string{"10001"}),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []string{"10050"}, resp.Result.TemplateIDs)
+}
+
+func TestTemplateMassRemove(t *testing.T) {
+	t.Parallel()
+
+	ts := newHostMassServer(t, zabbix.MethodTemplateMassRemove, map[string]any{
+		"templateids":      []any{"10050"},
+		"templateids_link": []any{"10001"},
+	}, `{"templateids":["10050"]}`)
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.TemplateMassRemove(context.Background(), zabbix.NewTemplateMassRemoveRequest(
+		[]string{"10050"},
+		zabbix.WithTemplateMassRemoveTemplateIDsLink([]string{"10001"}),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []string{"10050"}, resp.Result.TemplateIDs)
+}
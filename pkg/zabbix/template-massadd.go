@@ -0,0 +1,97 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+)
+
+// MethodTemplateMassAdd is the Zabbix API method for adding objects, e.g. linked templates, to several templates at once.
+const MethodTemplateMassAdd = "template.massadd"
+
+// TemplateMassAddParams defines the parameters for the Zabbix template.massadd API call.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/template/massadd
+type TemplateMassAddParams struct {
+	Templates     []TemplateID `json:"templates"`                // Templates to update.
+	TemplatesLink []TemplateID `json:"templates_link,omitempty"` // Templates to link to the templates.
+}
+
+// TemplateMassAddRequest defines the JSON-RPC request structure for template.massadd.
+type TemplateMassAddRequest struct {
+	JSONRPC string                `json:"jsonrpc"`
+	Method  string                `json:"method"`
+	Params  TemplateMassAddParams `json:"params"`
+	Auth    string                `json:"auth,omitempty"`
+	ID      int                   `json:"id"`
+}
+
+// TemplateIDsResult contains the 'templateids' returned by the template methods.
+type TemplateIDsResult struct {
+	TemplateIDs []string `json:"templateids"`
+}
+
+// TemplateMassAddResponse defines the JSON-RPC response structure for template.massadd.
+type TemplateMassAddResponse struct {
+	JSONRPC string            `json:"jsonrpc"`
+	Result  TemplateIDsResult `json:"result"`
+	ID      int               `json:"id"`
+	Error   *Error            `json:"error,omitempty"`
+}
+
+// TemplateMassAddOption defines a function signature for options to configure a TemplateMassAddRequest.
+type TemplateMassAddOption func(*TemplateMassAddRequest)
+
+// NewTemplateMassAddRequest creates a new TemplateMassAddRequest for the given template IDs and applies any provided options.
+func NewTemplateMassAddRequest(templateIDs []string, options ...TemplateMassAddOption) *TemplateMassAddRequest {
+	tmr := &TemplateMassAddRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodTemplateMassAdd,
+		Params:  TemplateMassAddParams{Templates: make([]TemplateID, 0, len(templateIDs))},
+	}
+	for _, id := range templateIDs {
+		tmr.Params.Templates = append(tmr.Params.Templates, TemplateID{TemplateID: id})
+	}
+	for _, opt := range options {
+		opt(tmr)
+	}
+	return tmr
+}
+
+// WithTemplateMassAddTemplatesLink sets the IDs of the templates to link to the templates.
+func WithTemplateMassAddTemplatesLink(templateIDs []string) TemplateMassAddOption {
+	return func(tmr *TemplateMassAddRequest) {
+		tmr.Params.TemplatesLink = make([]TemplateID, 0, len(templateIDs))
+		for _, id := range templateIDs {
+			tmr.Params.TemplatesLink = append(tmr.Params.TemplatesLink, TemplateID{TemplateID: id})
+		}
+	}
+}
+
+// WithTemplateMassAddAuth sets the authentication token for the API request.
+func WithTemplateMassAddAuth(token string) TemplateMassAddOption {
+	return func(tmr *TemplateMassAddRequest) { tmr.Auth = token }
+}
+
+// WithTemplateMassAddID sets the ID for the API request.
+func WithTemplateMassAddID(id int) TemplateMassAddOption {
+	return func(tmr *TemplateMassAddRequest) { tmr.ID = id }
+}
+
+// TemplateMassAdd sends a template.massadd request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) TemplateMassAdd(ctx context.Context, request *TemplateMassAddRequest) (*TemplateMassAddResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for template.massadd: %w", err)
+	}
+
+	var response TemplateMassAddResponse
+	if err := handleRawResponse(statusCode, respBody, MethodTemplateMassAdd, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
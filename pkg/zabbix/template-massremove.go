@@ -0,0 +1,92 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+)
+
+// MethodTemplateMassRemove is the Zabbix API method for removing objects, e.g. linked templates, from several templates at once.
+const MethodTemplateMassRemove = "template.massremove"
+
+// TemplateMassRemoveParams defines the parameters for the Zabbix template.massremove API call.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/template/massremove
+type TemplateMassRemoveParams struct {
+	TemplateIDs      []string `json:"templateids"`                 // Templates to update.
+	TemplateIDsLink  []string `json:"templateids_link,omitempty"`  // Templates to unlink from the templates.
+	TemplateIDsClear []string `json:"templateids_clear,omitempty"` // Templates to unlink and clear from the templates.
+}
+
+// TemplateMassRemoveRequest defines the JSON-RPC request structure for template.massremove.
+type TemplateMassRemoveRequest struct {
+	JSONRPC string                   `json:"jsonrpc"`
+	Method  string                   `json:"method"`
+	Params  TemplateMassRemoveParams `json:"params"`
+	Auth    string                   `json:"auth,omitempty"`
+	ID      int                      `json:"id"`
+}
+
+// TemplateMassRemoveResponse defines the JSON-RPC response structure for template.massremove.
+type TemplateMassRemoveResponse struct {
+	JSONRPC string            `json:"jsonrpc"`
+	Result  TemplateIDsResult `json:"result"`
+	ID      int               `json:"id"`
+	Error   *Error            `json:"error,omitempty"`
+}
+
+// TemplateMassRemoveOption defines a function signature for options to configure a TemplateMassRemoveRequest.
+type TemplateMassRemoveOption func(*TemplateMassRemoveRequest)
+
+// NewTemplateMassRemoveRequest creates a new TemplateMassRemoveRequest for the given template IDs and applies any provided options.
+func NewTemplateMassRemoveRequest(templateIDs []string, options ...TemplateMassRemoveOption) *TemplateMassRemoveRequest {
+	tmr := &TemplateMassRemoveRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodTemplateMassRemove,
+		Params:  TemplateMassRemoveParams{TemplateIDs: templateIDs},
+	}
+	for _, opt := range options {
+		opt(tmr)
+	}
+	return tmr
+}
+
+// WithTemplateMassRemoveTemplateIDsLink sets the IDs of the templates to unlink from the
+// templates. The entities inherited from them are kept.
+func WithTemplateMassRemoveTemplateIDsLink(templateIDs []string) TemplateMassRemoveOption {
+	return func(tmr *TemplateMassRemoveRequest) { tmr.Params.TemplateIDsLink = templateIDs }
+}
+
+// WithTemplateMassRemoveTemplateIDsClear sets the IDs of the templates to unlink and clear from
+// the templates: the entities inherited from them are deleted.
+func WithTemplateMassRemoveTemplateIDsClear(templateIDs []string) TemplateMassRemoveOption {
+	return func(tmr *TemplateMassRemoveRequest) { tmr.Params.TemplateIDsClear = templateIDs }
+}
+
+// WithTemplateMassRemoveAuth sets the authentication token for the API request.
+func WithTemplateMassRemoveAuth(token string) TemplateMassRemoveOption {
+	return func(tmr *TemplateMassRemoveRequest) { tmr.Auth = token }
+}
+
+// WithTemplateMassRemoveID sets the ID for the API request.
+func WithTemplateMassRemoveID(id int) TemplateMassRemoveOption {
+	return func(tmr *TemplateMassRemoveRequest) { tmr.ID = id }
+}
+
+// TemplateMassRemove sends a template.massremove request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) TemplateMassRemove(ctx context.Context, request *TemplateMassRemoveRequest) (*TemplateMassRemoveResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for template.massremove: %w", err)
+	}
+
+	var response TemplateMassRemoveResponse
+	if err := handleRawResponse(statusCode, respBody, MethodTemplateMassRemove, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
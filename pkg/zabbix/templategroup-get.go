@@ -0,0 +1,106 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+)
+
+// MethodTemplateGroupGet is the Zabbix API method for getting template groups.
+const MethodTemplateGroupGet = "templategroup.get"
+
+// TemplateGroup represents the Zabbix template group API object.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/templategroup/object
+type TemplateGroup struct {
+	GroupID string `json:"groupid,omitempty"`
+	Name    string `json:"name"`
+	UUID    string `json:"uuid,omitempty"` // Universal unique identifier, used for linking imported template groups to already existing ones.
+}
+
+// TemplateGroupGetParams defines the parameters for the Zabbix templategroup.get API call.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/templategroup/get
+type TemplateGroupGetParams struct {
+	CommonGetParams // Embeds common parameters like Output, Limit, Filter, etc.
+
+	GroupIDs    []string `json:"groupids,omitempty"`    // Return only template groups with the given IDs.
+	TemplateIDs []string `json:"templateids,omitempty"` // Return only template groups that contain the given templates.
+}
+
+// TemplateGroupGetRequest defines the JSON-RPC request structure for templategroup.get.
+type TemplateGroupGetRequest struct {
+	JSONRPC string                 `json:"jsonrpc"`
+	Method  string                 `json:"method"`
+	Params  TemplateGroupGetParams `json:"params"`
+	Auth    string                 `json:"auth,omitempty"`
+	ID      int                    `json:"id"`
+}
+
+// TemplateGroupGetResponse defines the JSON-RPC response structure for templategroup.get.
+type TemplateGroupGetResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  []TemplateGroup `json:"result"`
+	ID      int             `json:"id"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// TemplateGroupGetOption defines a function signature for options to configure a TemplateGroupGetRequest.
+type TemplateGroupGetOption func(*TemplateGroupGetRequest)
+
+// NewTemplateGroupGetRequest creates a new TemplateGroupGetRequest with default values and applies any provided options.
+func NewTemplateGroupGetRequest(options ...TemplateGroupGetOption) *TemplateGroupGetRequest {
+	tgr := &TemplateGroupGetRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodTemplateGroupGet,
+		Params: TemplateGroupGetParams{
+			CommonGetParams: CommonGetParams{Output: "extend"},
+		},
+	}
+	for _, opt := range options {
+		opt(tgr)
+	}
+	return tgr
+}
+
+// WithTemplateGroupGetFilter sets the filter parameter.
+func WithTemplateGroupGetFilter(filter map[string]any) TemplateGroupGetOption {
+	return func(tgr *TemplateGroupGetRequest) { tgr.Params.Filter = filter }
+}
+
+// WithTemplateGroupGetSearch sets the search parameter.
+func WithTemplateGroupGetSearch(search map[string]any) TemplateGroupGetOption {
+	return func(tgr *TemplateGroupGetRequest) { tgr.Params.Search = search }
+}
+
+// WithTemplateGroupGetSearchWildcardsEnabled sets the searchWildcardsEnabled flag.
+func WithTemplateGroupGetSearchWildcardsEnabled(flag bool) TemplateGroupGetOption {
+	return func(tgr *TemplateGroupGetRequest) { tgr.Params.SearchWildcardsEnabled = flag }
+}
+
+// WithTemplateGroupGetAuth sets the authentication token for the API request.
+func WithTemplateGroupGetAuth(token string) TemplateGroupGetOption {
+	return func(tgr *TemplateGroupGetRequest) { tgr.Auth = token }
+}
+
+// WithTemplateGroupGetID sets the ID for the API request.
+func WithTemplateGroupGetID(id int) TemplateGroupGetOption {
+	return func(tgr *TemplateGroupGetRequest) { tgr.ID = id }
+}
+
+// TemplateGroupGet sends a templategroup.get request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) TemplateGroupGet(ctx context.Context, request *TemplateGroupGetRequest) (*TemplateGroupGetResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for templategroup.get: %w", err)
+	}
+
+	var response TemplateGroupGetResponse
+	if err := handleRawResponse(statusCode, respBody, MethodTemplateGroupGet, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
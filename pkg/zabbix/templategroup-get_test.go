@@ -0,0 +1,38 @@
+package zabbix_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateGroupGet(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, zabbix.MethodTemplateGroupGet, req["method"])
+		require.Equal(t, map[string]any{
+			"output": "extend",
+			"filter": map[string]any{"name": []any{"Templates/Databases"}},
+		}, req["params"])
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"jsonrpc":"2.0","result":[{"groupid":"13","name":"Templates/Databases"}],"id":1}`)
+	}))
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.TemplateGroupGet(context.Background(), zabbix.NewTemplateGroupGetRequest(
+		zabbix.WithTemplateGroupGetFilter(map[string]any{"name": []string{"Templates/Databases"}}),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []zabbix.TemplateGroup{{GroupID: "13", Name: "Templates/Databases"}}, resp.Result)
+}
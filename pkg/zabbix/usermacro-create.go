@@ -0,0 +1,102 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+)
+
+// User macro methods creating, updating and deleting host (and template) macros and global macros.
+const (
+	MethodUserMacroCreate       = "usermacro.create"
+	MethodUserMacroUpdate       = "usermacro.update"
+	MethodUserMacroDelete       = "usermacro.delete"
+	MethodUserMacroCreateGlobal = "usermacro.createglobal"
+	MethodUserMacroUpdateGlobal = "usermacro.updateglobal"
+	MethodUserMacroDeleteGlobal = "usermacro.deleteglobal"
+)
+
+// UserMacroIDsResult contains the IDs returned by the usermacro methods: 'hostmacroids' for host
+// macros, 'globalmacroids' for global macros.
+type UserMacroIDsResult struct {
+	HostMacroIDs   []string `json:"hostmacroids,omitempty"`
+	GlobalMacroIDs []string `json:"globalmacroids,omitempty"`
+}
+
+// UserMacroResponse defines the JSON-RPC response structure for the usermacro create, update
+// and delete methods.
+type UserMacroResponse struct {
+	JSONRPC string             `json:"jsonrpc"`
+	Result  UserMacroIDsResult `json:"result"`
+	ID      int                `json:"id"`
+	Error   *Error             `json:"error,omitempty"`
+}
+
+// UserMacroCreateRequest defines the JSON-RPC request structure for usermacro.create and usermacro.createglobal.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/create
+type UserMacroCreateRequest struct {
+	JSONRPC string    `json:"jsonrpc"`
+	Method  string    `json:"method"`
+	Params  UserMacro `json:"params"` // 'hostid' is required for host macros.
+	Auth    string    `json:"auth,omitempty"`
+	ID      int       `json:"id"`
+}
+
+// UserMacroCreateOption defines a function signature for options to configure a UserMacroCreateRequest.
+type UserMacroCreateOption func(*UserMacroCreateRequest)
+
+// NewUserMacroCreateRequest creates a new usermacro.create request for the given host or template
+// macro and applies any provided options.
+func NewUserMacroCreateRequest(macro UserMacro, options ...UserMacroCreateOption) *UserMacroCreateRequest {
+	ucr := &UserMacroCreateRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodUserMacroCreate,
+		Params:  macro,
+	}
+	for _, opt := range options {
+		opt(ucr)
+	}
+	return ucr
+}
+
+// NewGlobalMacroCreateRequest creates a new usermacro.createglobal request for the given global
+// macro and applies any provided options.
+func NewGlobalMacroCreateRequest(macro UserMacro, options ...UserMacroCreateOption) *UserMacroCreateRequest {
+	ucr := NewUserMacroCreateRequest(macro, options...)
+	ucr.Method = MethodUserMacroCreateGlobal
+	return ucr
+}
+
+// WithUserMacroCreateAuth sets the authentication token for the API request.
+func WithUserMacroCreateAuth(token string) UserMacroCreateOption {
+	return func(ucr *UserMacroCreateRequest) { ucr.Auth = token }
+}
+
+// WithUserMacroCreateID sets the ID for the API request.
+func WithUserMacroCreateID(id int) UserMacroCreateOption {
+	return func(ucr *UserMacroCreateRequest) { ucr.ID = id }
+}
+
+// UserMacroCreate sends a usermacro.create or usermacro.createglobal request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) UserMacroCreate(ctx context.Context, request *UserMacroCreateRequest) (*UserMacroResponse, error) {
+	return z.userMacroRequest(ctx, request, request.Method)
+}
+
+// userMacroRequest sends a usermacro create, update or delete request to the Zabbix API.
+func (z *Client) userMacroRequest(ctx context.Context, request any, method string) (*UserMacroResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for %s: %w", method, err)
+	}
+
+	var response UserMacroResponse
+	if err := handleRawResponse(statusCode, respBody, method, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,54 @@
+package zabbix
+
+import "context"
+
+// UserMacroDeleteRequest defines the JSON-RPC request structure for usermacro.delete and usermacro.deleteglobal.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/delete
+type UserMacroDeleteRequest struct {
+	JSONRPC string   `json:"jsonrpc"`
+	Method  string   `json:"method"`
+	Params  []string `json:"params"` // IDs of the host macros or global macros to delete.
+	Auth    string   `json:"auth,omitempty"`
+	ID      int      `json:"id"`
+}
+
+// UserMacroDeleteOption defines a function signature for options to configure a UserMacroDeleteRequest.
+type UserMacroDeleteOption func(*UserMacroDeleteRequest)
+
+// NewUserMacroDeleteRequest creates a new usermacro.delete request for the given host macro IDs
+// and applies any provided options.
+func NewUserMacroDeleteRequest(hostMacroIDs []string, options ...UserMacroDeleteOption) *UserMacroDeleteRequest {
+	udr := &UserMacroDeleteRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodUserMacroDelete,
+		Params:  hostMacroIDs,
+	}
+	for _, opt := range options {
+		opt(udr)
+	}
+	return udr
+}
+
+// NewGlobalMacroDeleteRequest creates a new usermacro.deleteglobal request for the given global
+// macro IDs and applies any provided options.
+func NewGlobalMacroDeleteRequest(globalMacroIDs []string, options ...UserMacroDeleteOption) *UserMacroDeleteRequest {
+	udr := NewUserMacroDeleteRequest(globalMacroIDs, options...)
+	udr.Method = MethodUserMacroDeleteGlobal
+	return udr
+}
+
+// WithUserMacroDeleteAuth sets the authentication token for the API request.
+func WithUserMacroDeleteAuth(token string) UserMacroDeleteOption {
+	return func(udr *UserMacroDeleteRequest) { udr.Auth = token }
+}
+
+// WithUserMacroDeleteID sets the ID for the API request.
+func WithUserMacroDeleteID(id int) UserMacroDeleteOption {
+	return func(udr *UserMacroDeleteRequest) { udr.ID = id }
+}
+
+// UserMacroDelete sends a usermacro.delete or usermacro.deleteglobal request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) UserMacroDelete(ctx context.Context, request *UserMacroDeleteRequest) (*UserMacroResponse, error) {
+	return z.userMacroRequest(ctx, request, request.Method)
+}
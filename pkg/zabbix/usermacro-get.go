@@ -0,0 +1,112 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+)
+
+// MethodUserMacroGet is the Zabbix API method for getting host, template and global macros.
+const MethodUserMacroGet = "usermacro.get"
+
+// UserMacroGetParams defines the parameters for the Zabbix usermacro.get API call.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/get
+type UserMacroGetParams struct {
+	CommonGetParams // Embeds common parameters like Output, Limit, Filter, etc.
+
+	GlobalMacro    bool     `json:"globalmacro,omitempty"`    // Return global macros instead of host macros.
+	GlobalMacroIDs []string `json:"globalmacroids,omitempty"` // Return only global macros with the given IDs.
+	GroupIDs       []string `json:"groupids,omitempty"`       // Return only host macros of hosts or templates in the given groups.
+	HostIDs        []string `json:"hostids,omitempty"`        // Return only host macros of the given hosts or templates.
+	HostMacroIDs   []string `json:"hostmacroids,omitempty"`   // Return only host macros with the given IDs.
+	TemplateIDs    []string `json:"templateids,omitempty"`    // Return only host macros of the given templates.
+}
+
+// UserMacroGetRequest defines the JSON-RPC request structure for usermacro.get.
+type UserMacroGetRequest struct {
+	JSONRPC string             `json:"jsonrpc"`
+	Method  string             `json:"method"`
+	Params  UserMacroGetParams `json:"params"`
+	Auth    string             `json:"auth,omitempty"`
+	ID      int                `json:"id"`
+}
+
+// UserMacroGetResponse defines the JSON-RPC response structure for usermacro.get.
+type UserMacroGetResponse struct {
+	JSONRPC string      `json:"jsonrpc"`
+	Result  []UserMacro `json:"result"`
+	ID      int         `json:"id"`
+	Error   *Error      `json:"error,omitempty"`
+}
+
+// UserMacroGetOption defines a function signature for options to configure a UserMacroGetRequest.
+type UserMacroGetOption func(*UserMacroGetRequest)
+
+// NewUserMacroGetRequest creates a new UserMacroGetRequest with default values and applies any provided options.
+func NewUserMacroGetRequest(options ...UserMacroGetOption) *UserMacroGetRequest {
+	ugr := &UserMacroGetRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodUserMacroGet,
+		Params: UserMacroGetParams{
+			CommonGetParams: CommonGetParams{Output: "extend"},
+		},
+	}
+	for _, opt := range options {
+		opt(ugr)
+	}
+	return ugr
+}
+
+// WithUserMacroGetGlobalMacro sets the globalmacro flag, to get global macros.
+func WithUserMacroGetGlobalMacro(flag bool) UserMacroGetOption {
+	return func(ugr *UserMacroGetRequest) { ugr.Params.GlobalMacro = flag }
+}
+
+// WithUserMacroGetHostIDs sets the hostids parameter (IDs of hosts or templates).
+func WithUserMacroGetHostIDs(ids []string) UserMacroGetOption {
+	return func(ugr *UserMacroGetRequest) { ugr.Params.HostIDs = ids }
+}
+
+// WithUserMacroGetTemplateIDs sets the templateids parameter.
+func WithUserMacroGetTemplateIDs(ids []string) UserMacroGetOption {
+	return func(ugr *UserMacroGetRequest) { ugr.Params.TemplateIDs = ids }
+}
+
+// WithUserMacroGetFilter sets the filter parameter.
+func WithUserMacroGetFilter(filter map[string]any) UserMacroGetOption {
+	return func(ugr *UserMacroGetRequest) { ugr.Params.Filter = filter }
+}
+
+// WithUserMacroGetSortField sets the sortfield parameter.
+func WithUserMacroGetSortField(sortField []string) UserMacroGetOption {
+	return func(ugr *UserMacroGetRequest) { ugr.Params.SortField = sortField }
+}
+
+// WithUserMacroGetAuth sets the authentication token for the API request.
+func WithUserMacroGetAuth(token string) UserMacroGetOption {
+	return func(ugr *UserMacroGetRequest) { ugr.Auth = token }
+}
+
+// WithUserMacroGetID sets the ID for the API request.
+func WithUserMacroGetID(id int) UserMacroGetOption {
+	return func(ugr *UserMacroGetRequest) { ugr.ID = id }
+}
+
+// UserMacroGet sends a usermacro.get request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) UserMacroGet(ctx context.Context, request *UserMacroGetRequest) (*UserMacroGetResponse, error) {
+	statusCode, respBody, err := z.postRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed for usermacro.get: %w", err)
+	}
+
+	var response UserMacroGetResponse
+	if err := handleRawResponse(statusCode, respBody, MethodUserMacroGet, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil && response.Error.Code != 0 {
+		return nil, response.Error
+	}
+
+	return &response, nil
+}
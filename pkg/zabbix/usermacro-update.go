@@ -0,0 +1,54 @@
+package zabbix
+
+import "context"
+
+// UserMacroUpdateRequest defines the JSON-RPC request structure for usermacro.update and usermacro.updateglobal.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/update
+type UserMacroUpdateRequest struct {
+	JSONRPC string    `json:"jsonrpc"`
+	Method  string    `json:"method"`
+	Params  UserMacro `json:"params"` // 'hostmacroid' or 'globalmacroid' is required.
+	Auth    string    `json:"auth,omitempty"`
+	ID      int       `json:"id"`
+}
+
+// UserMacroUpdateOption defines a function signature for options to configure a UserMacroUpdateRequest.
+type UserMacroUpdateOption func(*UserMacroUpdateRequest)
+
+// NewUserMacroUpdateRequest creates a new usermacro.update request for the given host or template
+// macro and applies any provided options.
+func NewUserMacroUpdateRequest(macro UserMacro, options ...UserMacroUpdateOption) *UserMacroUpdateRequest {
+	uur := &UserMacroUpdateRequest{
+		JSONRPC: JSONRPC,
+		Method:  MethodUserMacroUpdate,
+		Params:  macro,
+	}
+	for _, opt := range options {
+		opt(uur)
+	}
+	return uur
+}
+
+// NewGlobalMacroUpdateRequest creates a new usermacro.updateglobal request for the given global
+// macro and applies any provided options.
+func NewGlobalMacroUpdateRequest(macro UserMacro, options ...UserMacroUpdateOption) *UserMacroUpdateRequest {
+	uur := NewUserMacroUpdateRequest(macro, options...)
+	uur.Method = MethodUserMacroUpdateGlobal
+	return uur
+}
+
+// WithUserMacroUpdateAuth sets the authentication token for the API request.
+func WithUserMacroUpdateAuth(token string) UserMacroUpdateOption {
+	return func(uur *UserMacroUpdateRequest) { uur.Auth = token }
+}
+
+// WithUserMacroUpdateID sets the ID for the API request.
+func WithUserMacroUpdateID(id int) UserMacroUpdateOption {
+	return func(uur *UserMacroUpdateRequest) { uur.ID = id }
+}
+
+// UserMacroUpdate sends a usermacro.update or usermacro.updateglobal request to the Zabbix API.
+// The request object should be fully populated by the caller, including Auth and ID.
+func (z *Client) UserMacroUpdate(ctx context.Context, request *UserMacroUpdateRequest) (*UserMacroResponse, error) {
+	return z.userMacroRequest(ctx, request, request.Method)
+}
@@ -0,0 +1,26 @@
+package zabbix
+
+// User macro types.
+const (
+	UserMacroTypeText   = "0"
+	UserMacroTypeSecret = "1"
+	UserMacroTypeVault  = "2"
+)
+
+// UserMacro represents the Zabbix host, template or global macro API object.
+// Host macros have a HostMacroID and a HostID (the ID of the host or template), global macros a GlobalMacroID.
+// See: https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/object
+type UserMacro struct {
+	HostMacroID   string `json:"hostmacroid,omitempty"`
+	GlobalMacroID string `json:"globalmacroid,omitempty"`
+	HostID        string `json:"hostid,omitempty"`
+	Macro         string `json:"macro"`                 // Macro name, e.g. {$SNMP_COMMUNITY}.
+	Value         string `json:"value,omitempty"`       // Macro value. Never returned for secret macros.
+	Type          string `json:"type,omitempty"`        // 0 - (default) text; 1 - secret; 2 - vault secret.
+	Description   string `json:"description,omitempty"` // Description of the macro.
+}
+
+// IsSecret returns true if the value of the macro is secret.
+func (m *UserMacro) IsSecret() bool {
+	return m.Type == UserMacroTypeSecret
+}
@@ -0,0 +1,98 @@
+package zabbix_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sgaunet/zabbix-cli/pkg/zabbix"
+	"github.com/stretchr/testify/require"
+)
+
+// newUserMacroServer returns a test server checking the method of the request and answering result.
+func newUserMacroServer(t *testing.T, method string, check func(params any), result string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, method, req["method"])
+		check(req["params"])
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","result":%s,"id":1}`+"\n", result)
+	}))
+}
+
+func TestUserMacroGet(t *testing.T) {
+	t.Parallel()
+
+	ts := newUserMacroServer(t, zabbix.MethodUserMacroGet, func(params any) {
+		require.Equal(t, []any{"10084"}, params.(map[string]any)["hostids"])
+		require.NotContains(t, params, "globalmacro")
+	}, `[{"hostmacroid":"1","hostid":"10084","macro":"{$PORT}","value":"8080","type":"0"},
+		{"hostmacroid":"2","hostid":"10084","macro":"{$PASS}","type":"1"}]`)
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.UserMacroGet(context.Background(), zabbix.NewUserMacroGetRequest(
+		zabbix.WithUserMacroGetHostIDs([]string{"10084"}),
+	))
+	require.NoError(t, err)
+	require.Len(t, resp.Result, 2)
+	require.Equal(t, "8080", resp.Result[0].Value)
+	require.False(t, resp.Result[0].IsSecret())
+	require.True(t, resp.Result[1].IsSecret())
+}
+
+func TestGlobalMacroCreate(t *testing.T) {
+	t.Parallel()
+
+	ts := newUserMacroServer(t, zabbix.MethodUserMacroCreateGlobal, func(params any) {
+		require.Equal(t, map[string]any{"macro": "{$SNMP_COMMUNITY}", "value": "public", "type": "1"}, params)
+	}, `{"globalmacroids":["6"]}`)
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.UserMacroCreate(context.Background(), zabbix.NewGlobalMacroCreateRequest(zabbix.UserMacro{
+		Macro: "{$SNMP_COMMUNITY}",
+		Value: "public",
+		Type:  zabbix.UserMacroTypeSecret,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"6"}, resp.Result.GlobalMacroIDs)
+}
+
+func TestUserMacroUpdate(t *testing.T) {
+	t.Parallel()
+
+	ts := newUserMacroServer(t, zabbix.MethodUserMacroUpdate, func(params any) {
+		require.Equal(t, map[string]any{"hostmacroid": "1", "macro": "{$PORT}", "value": "9090"}, params)
+	}, `{"hostmacroids":["1"]}`)
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.UserMacroUpdate(context.Background(), zabbix.NewUserMacroUpdateRequest(zabbix.UserMacro{
+		HostMacroID: "1",
+		Macro:       "{$PORT}",
+		Value:       "9090",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, resp.Result.HostMacroIDs)
+}
+
+func TestUserMacroDelete(t *testing.T) {
+	t.Parallel()
+
+	ts := newUserMacroServer(t, zabbix.MethodUserMacroDeleteGlobal, func(params any) {
+		require.Equal(t, []any{"6", "7"}, params)
+	}, `{"globalmacroids":["6","7"]}`)
+	defer ts.Close()
+
+	z := zabbix.New("user", "pass", ts.URL)
+	resp, err := z.UserMacroDelete(context.Background(), zabbix.NewGlobalMacroDeleteRequest([]string{"6", "7"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"6", "7"}, resp.Result.GlobalMacroIDs)
+}